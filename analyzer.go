@@ -0,0 +1,163 @@
+package rslp
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Filter transforms the token stream of an Analyzer. It may change, drop or
+// add tokens, and returns the resulting stream.
+type Filter func(tokens []Token) []Token
+
+// Analyzer splits a text into its words, separated by white space, and runs
+// its filters on them in order, similar to the analyzers of Lucene. The
+// positions of the tokens are assigned by the tokenizer, so dropping a token
+// leaves a gap in the positions.
+type Analyzer struct {
+	filters []Filter
+}
+
+// NewAnalyzer returns an analyzer running the filters in the given order.
+func NewAnalyzer(filters ...Filter) *Analyzer {
+	return &Analyzer{filters: filters}
+}
+
+// Analyze returns the tokens of the text after all the filters ran.
+func (a *Analyzer) Analyze(text string) []Token {
+	tokens := tokenize(text)
+	for _, f := range a.filters {
+		tokens = f(tokens)
+	}
+	return tokens
+}
+
+// Terms returns the text of the tokens of the text after all the filters
+// ran.
+func (a *Analyzer) Terms(text string) []string {
+	tokens := a.Analyze(text)
+	terms := make([]string, len(tokens))
+	for i, t := range tokens {
+		terms[i] = t.Text
+	}
+	return terms
+}
+
+// mapFilter returns a filter replacing the text of each token by f(text).
+func mapFilter(f func(string) string) Filter {
+	return func(tokens []Token) []Token {
+		for i := range tokens {
+			tokens[i].Text = f(tokens[i].Text)
+		}
+		return tokens
+	}
+}
+
+// dropFilter returns a filter dropping the tokens for which drop is true.
+func dropFilter(drop func(Token) bool) Filter {
+	return func(tokens []Token) []Token {
+		kept := tokens[:0]
+		for _, t := range tokens {
+			if !drop(t) {
+				kept = append(kept, t)
+			}
+		}
+		return kept
+	}
+}
+
+// LowercaseFilter lowercases the tokens.
+func LowercaseFilter() Filter {
+	return mapFilter(strings.ToLower)
+}
+
+// NFCFilter normalizes the tokens to the Unicode normalization form C, so
+// that precomposed and decomposed accents compare equal.
+func NFCFilter() Filter {
+	return mapFilter(norm.NFC.String)
+}
+
+// FoldFilter removes the diacritics of the tokens. Tokens made only of
+// combining marks are kept unchanged.
+func FoldFilter() Filter {
+	return mapFilter(func(text string) string {
+		if s, _, err := transform.String(normalize, text); err == nil && s != "" {
+			return s
+		}
+		return text
+	})
+}
+
+// PunctuationFilter removes the characters other than letters and digits
+// around the tokens, dropping the tokens made only of punctuation.
+func PunctuationFilter() Filter {
+	trim := mapFilter(trimPunctuation)
+	drop := dropFilter(func(t Token) bool { return t.Text == "" })
+	return func(tokens []Token) []Token {
+		return drop(trim(tokens))
+	}
+}
+
+// ContractionFilter splits the contractions of prepositions into their
+// components, which take consecutive positions.
+func ContractionFilter() Filter {
+	return expandTokens
+}
+
+// StopwordFilter drops the tokens that are in the stopword list.
+func StopwordFilter(sw *Stopwords) Filter {
+	return dropFilter(func(t Token) bool { return sw.Contains(t.Text) })
+}
+
+// StopwordMarkFilter flags the tokens that are in the stopword list,
+// without dropping them.
+func StopwordMarkFilter(sw *Stopwords) Filter {
+	return func(tokens []Token) []Token {
+		for i := range tokens {
+			tokens[i].Stopword = tokens[i].Stopword || sw.Contains(tokens[i].Text)
+		}
+		return tokens
+	}
+}
+
+// KeywordFilter protects the tokens equal to one of the words, compared
+// case-insensitively, from being stemmed by the StemFilter.
+func KeywordFilter(words ...string) Filter {
+	protected := make(map[string]bool, len(words))
+	for _, w := range words {
+		protected[strings.ToLower(w)] = true
+	}
+	return func(tokens []Token) []Token {
+		for i := range tokens {
+			tokens[i].Keyword = tokens[i].Keyword || protected[strings.ToLower(tokens[i].Text)]
+		}
+		return tokens
+	}
+}
+
+// StemFilter stems the tokens that are not keywords with the stemmer,
+// setting both their text and their stem.
+func StemFilter(s Stemmer) Filter {
+	return func(tokens []Token) []Token {
+		for i, t := range tokens {
+			if t.Keyword {
+				tokens[i].Stem = t.Text
+				continue
+			}
+			tokens[i].Stem = s.Stem(t.Text)
+			tokens[i].Text = tokens[i].Stem
+		}
+		return tokens
+	}
+}
+
+// LengthFilter drops the tokens shorter than min or longer than max
+// characters. A max of zero or less sets no upper bound.
+func LengthFilter(min, max int) Filter {
+	return dropFilter(func(t Token) bool {
+		n := utf8.RuneCountInString(t.Text)
+		return n < min || max > 0 && n > max
+	})
+}
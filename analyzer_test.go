@@ -0,0 +1,114 @@
+package rslp
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAnalyzer(t *testing.T) {
+	tests := []struct {
+		analyzer *Analyzer
+		input    string
+		want     []string
+	}{
+		{
+			NewAnalyzer(),
+			"Meninas do Brasil.",
+			[]string{"Meninas", "do", "Brasil."},
+		},
+		{
+			NewAnalyzer(LowercaseFilter(), PunctuationFilter(), StemFilter(Options{})),
+			"Meninas do Brasil.",
+			[]string{"menin", "do", "brasil"},
+		},
+		{
+			NewAnalyzer(LowercaseFilter(), PunctuationFilter(), ContractionFilter(), StopwordFilter(BrazilianStopwords), StemFilter(Options{})),
+			"Meninas do Brasil.",
+			[]string{"menin", "brasil"},
+		},
+		{
+			NewAnalyzer(PunctuationFilter(), KeywordFilter("brasileiras"), StemFilter(Options{})),
+			"Brasileiras cantoras",
+			[]string{"Brasileiras", "can"},
+		},
+		{
+			NewAnalyzer(LowercaseFilter(), StemFilter(Options{KeepDiacritics: true}), FoldFilter()),
+			"Canções",
+			[]string{"canca"},
+		},
+		{
+			NewAnalyzer(NFCFilter()),
+			"café",
+			[]string{"café"},
+		},
+		{
+			NewAnalyzer(PunctuationFilter(), LengthFilter(3, 6)),
+			"o gato - comilão",
+			[]string{"gato"},
+		},
+		{
+			NewAnalyzer(LengthFilter(2, 0)),
+			"a paralelepípedo",
+			[]string{"paralelepípedo"},
+		},
+		{
+			NewAnalyzer(LowercaseFilter(), StemFilter(Snowball{})),
+			"Quintessência",
+			[]string{"quintessent"},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := tt.analyzer.Terms(tt.input)
+
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Fatalf("invalid analyzer output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAnalyzerPositions(t *testing.T) {
+	a := NewAnalyzer(LowercaseFilter(), ContractionFilter(), StopwordFilter(BrazilianStopwords), StemFilter(Options{}))
+	got := a.Analyze("Meninas pelas ruas")
+	want := []Token{
+		{Text: "menin", Stem: "menin", Start: 0, End: 7, Position: 0},
+		{Text: "rua", Stem: "rua", Start: 14, End: 18, Position: 3},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid tokens\nwant %+v\n got %+v", want, got)
+	}
+}
+
+func TestStopwordMarkFilter(t *testing.T) {
+	a := NewAnalyzer(StopwordMarkFilter(EuropeanStopwords))
+	var flags []string
+	for _, tok := range a.Analyze("Vós cantais") {
+		flags = append(flags, fmt.Sprintf("%s:%v", tok.Text, tok.Stopword))
+	}
+
+	if got, want := strings.Join(flags, " "), "Vós:true cantais:false"; got != want {
+		t.Fatalf("invalid flags, want %q (got %q)", want, got)
+	}
+}
+
+func ExampleAnalyzer() {
+	a := NewAnalyzer(
+		LowercaseFilter(),
+		PunctuationFilter(),
+		ContractionFilter(),
+		StopwordFilter(BrazilianStopwords),
+		StemFilter(Options{}),
+	)
+	for _, t := range a.Analyze("As meninas cantavam nas ruas.") {
+		fmt.Println(t.Position, t.Text)
+	}
+	// Output:
+	// 1 menin
+	// 2 cant
+	// 5 rua
+}
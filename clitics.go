@@ -0,0 +1,100 @@
+package rslp
+
+import "strings"
+
+// cliticPronouns are the unstressed pronouns attached to verbs with hyphens,
+// including their contractions ("mo" = "me" + "o").
+var cliticPronouns = map[string]bool{
+	"me": true, "te": true, "se": true, "nos": true, "vos": true,
+	"lhe": true, "lhes": true,
+	"o": true, "a": true, "os": true, "as": true,
+	"lo": true, "la": true, "los": true, "las": true,
+	"no": true, "na": true, "nas": true,
+	"mo": true, "ma": true, "mos": true, "mas": true,
+	"to": true, "ta": true, "tos": true, "tas": true,
+	"lho": true, "lha": true, "lhos": true, "lhas": true,
+}
+
+// mesoclisisEndings are the endings of the future and conditional tenses,
+// which follow the pronoun in mesoclisis ("dir-se-á", "fá-lo-ia").
+var mesoclisisEndings = map[string]bool{
+	"ei": true, "ás": true, "á": true, "emos": true, "eis": true, "ão": true,
+	"ia": true, "ias": true, "íamos": true, "íeis": true, "iam": true,
+}
+
+// cliticInfinitives restores the final consonant of the verbs dropped
+// before the "lo", "la", "los" and "las" pronouns ("vendê-lo" = "vender" +
+// "o", "fizemo-lo" = "fizemos" + "o").
+var cliticInfinitives = []struct {
+	suffix, replacement string
+}{
+	{"á", "ar"},
+	{"ê", "er"},
+	{"é", "er"},
+	{"í", "ir"},
+	{"i", "ir"},
+	{"ô", "or"},
+	{"mo", "mos"},
+}
+
+// cliticIrregulars are the verbs ending in "z" or "s" that drop it before the
+// "lo", "la", "los" and "las" pronouns ("fi-lo" = "fiz" + "o", "di-lo" =
+// "diz" + "o"), which cliticInfinitives would turn into infinitives. In
+// mesoclisis the same forms are infinitives ("fá-lo-ia" = "faria").
+var cliticIrregulars = map[string]string{
+	// fazer
+	"fi": "fiz", "refi": "refiz", "desfi": "desfiz", "satisfi": "satisfiz",
+	"fê": "fez", "refê": "refez", "desfê": "desfez", "satisfê": "satisfez",
+	"fá": "faz", "refá": "refaz", "desfá": "desfaz", "satisfá": "satisfaz",
+	// dizer
+	"di": "diz", "bendi": "bendiz", "contradi": "contradiz", "desdi": "desdiz",
+	"maldi": "maldiz", "predi": "prediz",
+	// trazer
+	"trá": "traz",
+	// querer and requerer
+	"qui": "quis", "requi": "requis",
+	// pôr
+	"pu": "pus", "compu": "compus", "dispu": "dispus", "expu": "expus",
+	"impu": "impus", "propu": "propus", "repu": "repus", "supu": "supus",
+}
+
+// detachClitics removes the enclitic and mesoclitic pronouns of a lowercase
+// verb, restoring its ending: "vendê-lo" becomes "vender", "dá-lhe" becomes
+// "dá" and "dir-se-á" becomes "dirá". Words whose hyphenated parts are not
+// all pronouns, such as "guarda-chuva", are returned unchanged.
+func detachClitics(word string) string {
+	parts := strings.Split(word, "-")
+	if len(parts) < 2 || parts[0] == "" {
+		return word
+	}
+
+	var ending string
+	if n := len(parts); n > 2 && mesoclisisEndings[parts[n-1]] {
+		ending, parts = parts[n-1], parts[:n-1]
+	}
+	for _, p := range parts[1:] {
+		if !cliticPronouns[p] {
+			return word
+		}
+	}
+
+	verb := parts[0]
+	switch parts[1] {
+	case "lo", "la", "los", "las":
+		if v, ok := cliticIrregulars[verb]; ok && ending == "" {
+			verb = v
+			break
+		}
+		for _, r := range cliticInfinitives {
+			if strings.HasSuffix(verb, r.suffix) {
+				verb = strings.TrimSuffix(verb, r.suffix) + r.replacement
+				break
+			}
+		}
+	case "nos":
+		if strings.HasSuffix(verb, "mo") {
+			verb += "s"
+		}
+	}
+	return verb + ending
+}
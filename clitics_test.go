@@ -0,0 +1,91 @@
+package rslp
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDetachClitics(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		// Enclisis
+		{"dá-lhe", "dá"},
+		{"utilizar-se", "utilizar"},
+		{"vendeu-se", "vendeu"},
+		{"vendê-lo", "vender"},
+		{"amá-la", "amar"},
+		{"parti-los", "partir"},
+		{"pô-las", "por"},
+		{"pô-lo", "por"},
+		{"compô-lo", "compor"},
+		{"dispô-las", "dispor"},
+		{"fizemo-lo", "fizemos"},
+		{"fi-lo", "fiz"},
+		{"qui-lo", "quis"},
+		{"fê-lo", "fez"},
+		{"fá-lo", "faz"},
+		{"di-lo", "diz"},
+		{"trá-las", "traz"},
+		{"pu-lo", "pus"},
+		{"desfi-lo", "desfiz"},
+		{"pedi-lo", "pedir"},
+		{"vamo-nos", "vamos"},
+		{"dão-no", "dão"},
+		{"dão-no-lo", "dão"},
+		{"deu-mo", "deu"},
+		// Mesoclisis
+		{"dir-se-á", "dirá"},
+		{"fazê-lo-ia", "fazeria"},
+		{"amá-la-ei", "amarei"},
+		{"pô-lo-ei", "porei"},
+		{"fá-lo-ia", "faria"},
+		{"di-lo-ei", "direi"},
+		{"dar-te-emos", "daremos"},
+		{"vender-lhes-íamos", "venderíamos"},
+		// Not clitics
+		{"guarda-chuva", "guarda-chuva"},
+		{"segunda-feira", "segunda-feira"},
+		{"bem-te-vi", "bem-te-vi"},
+		{"-se", "-se"},
+		{"casa", "casa"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := detachClitics(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid detach output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStemDetachClitics(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"vendê-lo", "vend"},
+		{"dir-se-á", "dir"},
+		{"fazê-lo-ia", "faz"},
+		{"utilizar-se", "utiliz"},
+		{"dá-lhe", "dá"},
+		{"compô-lo", "comp"},
+		{"Amá-la-ei", "am"},
+		{"guarda-chuva", "guarda-chuv"},
+	}
+
+	opts := Options{DetachClitics: true}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := opts.Stem(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+//go:build cgo
+// +build cgo
+
+// Command librslp builds the RSLP stemmer as a C shared library, so that
+// programs written in other languages, through their foreign function
+// interface, get the same stems as Go ones:
+//
+//	go build -buildmode=c-shared -o librslp.so github.com/knuppe/rslp/cmd/librslp
+//
+// The functions of the library are declared in rslp.h. They take strings
+// encoded in UTF-8 and terminated by a NUL byte, along with the options of
+// the stemmer as a JSON object with the fields of rslp.Config, or NULL for
+// the default options. They return strings allocated by the library, which
+// the caller owns and must release with rslp_free, or NULL on error with the
+// error message stored in *error when error is not NULL. The library keeps
+// no reference to the strings passed to it and can be called from several
+// threads at once.
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"unsafe"
+
+	"github.com/knuppe/rslp"
+)
+
+// options returns the stemmer options of a JSON config, or the default
+// options if it is NULL.
+func options(config *C.char) (rslp.Options, error) {
+	if config == nil {
+		return rslp.Options{}, nil
+	}
+	var c rslp.Config
+	dec := json.NewDecoder(bytes.NewReader([]byte(C.GoString(config))))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&c); err != nil {
+		return rslp.Options{}, fmt.Errorf("rslp: invalid options: %w", err)
+	}
+	if err := dec.Decode(&struct{}{}); err != io.EOF {
+		return rslp.Options{}, fmt.Errorf("rslp: invalid options: data after the JSON object")
+	}
+	return c.Options()
+}
+
+// call applies f to the input with the options, and returns the result as a
+// C string or NULL on error, reporting the error in errp.
+func call(input, config *C.char, errp **C.char, f func(o rslp.Options, s string) string) *C.char {
+	if errp != nil {
+		*errp = nil
+	}
+	fail := func(err error) *C.char {
+		if errp != nil {
+			*errp = C.CString(err.Error())
+		}
+		return nil
+	}
+
+	if input == nil {
+		return fail(fmt.Errorf("rslp: NULL input"))
+	}
+	o, err := options(config)
+	if err != nil {
+		return fail(err)
+	}
+	return C.CString(f(o, C.GoString(input)))
+}
+
+//export rslp_stem
+func rslp_stem(word, config *C.char, errp **C.char) *C.char {
+	return call(word, config, errp, rslp.Options.Stem)
+}
+
+//export rslp_stem_sentence
+func rslp_stem_sentence(sentence, config *C.char, errp **C.char) *C.char {
+	return call(sentence, config, errp, rslp.Options.StemSentence)
+}
+
+//export rslp_free
+func rslp_free(s *C.char) {
+	C.free(unsafe.Pointer(s))
+}
+
+func main() {}
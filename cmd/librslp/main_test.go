@@ -0,0 +1,76 @@
+//go:build cgo
+// +build cgo
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestLibrary builds the shared library and runs a C program linked to it.
+func TestLibrary(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the shared library")
+	}
+	cc, err := exec.LookPath(os.Getenv("CC"))
+	if err != nil {
+		if cc, err = exec.LookPath("cc"); err != nil {
+			t.Skip("no C compiler")
+		}
+	}
+
+	dir := t.TempDir()
+	build := exec.Command("go", "build", "-buildmode=c-shared", "-o", filepath.Join(dir, "librslp.so"), ".")
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("building the library: %v\n%s", err, out)
+	}
+	prog := filepath.Join(dir, "stem")
+	compile := exec.Command(cc, "-std=c99", "-Wall", "-Werror", "-I.", "-o", prog, "testdata/stem.c",
+		"-L"+dir, "-lrslp", "-Wl,-rpath,"+dir)
+	if out, err := compile.CombinedOutput(); err != nil {
+		t.Fatalf("compiling the program: %v\n%s", err, out)
+	}
+
+	tests := []struct {
+		options string
+		args    []string
+		want    string
+	}{
+		{
+			args: []string{"Os gatos correram.", "canções"},
+			want: "os gatos correram.\ncanca\nos gat correram.\nerror: rslp: NULL input\n",
+		},
+		{
+			options: `{"stopwords": "pt-br", "keep_diacritics": true}`,
+			args:    []string{"Os gatos", "canções"},
+			want:    "os gat\ncançã\ngat\nerror: rslp: NULL input\n",
+		},
+		{
+			options: `{"rules": "portuguese"} xyz`,
+			args:    []string{"gatos"},
+			want:    "error: rslp: invalid options: data after the JSON object\nerror: rslp: invalid options: data after the JSON object\nerror: rslp: NULL input\n",
+		},
+		{
+			options: `{"stopwords": "en"}`,
+			args:    []string{"gatos"},
+			want:    "error: rslp: unknown stopword list \"en\"\nerror: rslp: unknown stopword list \"en\"\nerror: rslp: NULL input\n",
+		},
+	}
+	for _, test := range tests {
+		cmd := exec.Command(prog, test.args...)
+		cmd.Env = append(os.Environ(), "RSLP_OPTIONS="+test.options)
+		if test.options == "" {
+			cmd.Env = os.Environ()
+		}
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("%v: %v\n%s", test.args, err, out)
+		}
+		if string(out) != test.want {
+			t.Errorf("%v: invalid output\n%s\nwant\n%s", test.args, out, test.want)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+/*
+ * rslp.h - the RSLP stemmer for Portuguese as a C library.
+ *
+ * Build the library with
+ *
+ *     go build -buildmode=c-shared -o librslp.so github.com/knuppe/rslp/cmd/librslp
+ *
+ * Strings are encoded in UTF-8 and terminated by a NUL byte. Invalid UTF-8
+ * bytes are replaced by U+FFFD before stemming.
+ *
+ * The options of the stemmer are a JSON object with the fields of
+ * rslp.Config, such as {"rules": "portuguese", "stopwords": "pt-br"}, or
+ * NULL for the default options.
+ *
+ * Memory ownership: the library keeps no reference to the strings passed to
+ * it. The strings it returns, including error messages, belong to the caller,
+ * who must release them with rslp_free, and not with free, once done.
+ *
+ * On error, the functions return NULL and, if error is not NULL, store a
+ * message in *error. Otherwise *error is set to NULL.
+ *
+ * The functions may be called from several threads at once.
+ */
+#ifndef RSLP_H
+#define RSLP_H
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+/* rslp_stem returns the stem of a single word. */
+char *rslp_stem(const char *word, const char *options, char **error);
+
+/*
+ * rslp_stem_sentence returns the stems of the words of a sentence, separated
+ * by single spaces, without the stopwords.
+ */
+char *rslp_stem_sentence(const char *sentence, const char *options, char **error);
+
+/* rslp_free releases a string returned by the library. NULL is ignored. */
+void rslp_free(char *s);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif /* RSLP_H */
@@ -0,0 +1,36 @@
+// stem.c prints the stem of each of its arguments and the stems of its
+// first argument as a sentence, with the options in RSLP_OPTIONS, followed
+// by the error for a NULL word.
+#include <stdio.h>
+#include <stdlib.h>
+
+#include "rslp.h"
+
+static void print(char *s, char *error) {
+	if (s == NULL) {
+		printf("error: %s\n", error);
+		rslp_free(error);
+		return;
+	}
+	printf("%s\n", s);
+	rslp_free(s);
+}
+
+int main(int argc, char **argv) {
+	const char *options = getenv("RSLP_OPTIONS");
+	char *error;
+
+	char *s;
+
+	for (int i = 1; i < argc; i++) {
+		s = rslp_stem(argv[i], options, &error);
+		print(s, error);
+	}
+	if (argc > 1) {
+		s = rslp_stem_sentence(argv[1], options, &error);
+		print(s, error);
+	}
+	s = rslp_stem(NULL, options, &error);
+	print(s, error);
+	return 0;
+}
@@ -0,0 +1,130 @@
+// Command rslp-server serves the RSLP stemmer over HTTP, so that services
+// written in other languages get the same stems as Go ones.
+//
+// Usage:
+//
+//	rslp-server [flags]
+//
+// The endpoints take and return JSON, and accept a single input or a batch:
+//
+//	POST /stem            {"word": "gatinhas"} or {"words": [...]}
+//	POST /stem/sentence   {"text": "Os gatos."} or {"texts": [...]}
+//	POST /tokens          {"text": "Os gatos."} or {"texts": [...]}
+//	POST /explain         {"word": "gatinhas"} or {"words": [...]}
+//	GET  /healthz
+//
+// They answer {"result": ...} for a single input and {"results": [...]} for
+// a batch, in the order of the inputs, or {"error": "..."} with a status of
+// 400 for invalid requests and 413 for requests over the limits. Clients
+// taking longer than the -timeout flag to send a request or to receive its
+// response are disconnected.
+//
+// A request may override the options of the server with an "options" object
+// with the fields of rslp.Config. The health endpoint reports the rule set
+// of the server, its version and the fingerprint of the options, which
+// clients can compare to detect a change of the stems.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/knuppe/rslp"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("rslp-server", flag.ContinueOnError)
+	addr := fs.String("addr", "localhost:8080", "address to listen on")
+	rules := fs.String("rules", rslp.Portuguese.Name(), "built-in rule set name or rule file")
+	stopwords := fs.String("stopwords", "", "built-in stopword list: pt-br or pt-pt")
+	keepDiacritics := fs.Bool("keep-diacritics", false, "keep the diacritics of the stems")
+	preserveCase := fs.Bool("preserve-case", false, "keep the case of the words")
+	orthography := fs.Bool("orthography", false, "normalize the spelling before the 1990 agreement")
+	clitics := fs.Bool("clitics", false, "detach the clitic pronouns of the verbs")
+	contractions := fs.Bool("contractions", false, "expand the contractions of prepositions")
+	maxBytes := fs.Int64("max-bytes", 1<<20, "maximum size of a request body in bytes")
+	maxBatch := fs.Int("max-batch", 1000, "maximum number of inputs of a batch")
+	timeout := fs.Duration("timeout", 30*time.Second, "maximum duration of reading a request and of writing its response")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	s, err := newServer(*rules, rslp.Config{
+		KeepDiacritics:     *keepDiacritics,
+		PreserveCase:       *preserveCase,
+		Orthography:        *orthography,
+		DetachClitics:      *clitics,
+		ExpandContractions: *contractions,
+		Stopwords:          *stopwords,
+	})
+	if err != nil {
+		return err
+	}
+	s.maxBytes, s.maxBatch = *maxBytes, *maxBatch
+
+	srv := httpServer(*addr, s.handler(), *timeout)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errc := make(chan error, 1)
+	go func() {
+		log.Printf("rslp-server: listening on %s", *addr)
+		errc <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Print("rslp-server: shutting down")
+	shutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdown); err != nil {
+		return err
+	}
+	if err := <-errc; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// httpServer returns the HTTP server of the handler, which gives up on
+// clients taking longer than timeout to send a request or to receive its
+// response.
+func httpServer(addr string, h http.Handler, timeout time.Duration) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           h,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       timeout,
+		WriteTimeout:      timeout,
+		IdleTimeout:       2 * time.Minute,
+	}
+}
+
+// loadRules loads the rule file at the path. It returns nil for the name of
+// a built-in rule set, which rslp.Config looks up by itself.
+func loadRules(name string) (*rslp.RuleSet, error) {
+	if rslp.BuiltinRules(name) != nil {
+		return nil, nil
+	}
+	return rslp.LoadRulesFile(name)
+}
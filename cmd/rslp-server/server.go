@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/knuppe/rslp"
+)
+
+// server answers the stemming requests.
+type server struct {
+	base     rslp.Config
+	rules    []*rslp.RuleSet // rule files loaded by the server
+	maxBytes int64
+	maxBatch int
+}
+
+// newServer returns a server stemming with the rule set, a built-in name or
+// a rule file, and the options of the config.
+func newServer(rules string, base rslp.Config) (*server, error) {
+	s := &server{base: base, maxBytes: 1 << 20, maxBatch: 1000}
+	rs, err := loadRules(rules)
+	if err != nil {
+		return nil, err
+	}
+	s.base.Rules = rules
+	if rs != nil {
+		s.base.Rules = rs.Name()
+		s.rules = []*rslp.RuleSet{rs}
+	}
+	if _, err := s.base.Options(s.rules...); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/stem", s.endpoint("word", func(o rslp.Options, word string) interface{} {
+		return o.Stem(word)
+	}))
+	mux.HandleFunc("/stem/sentence", s.endpoint("text", func(o rslp.Options, text string) interface{} {
+		return o.StemSentence(text)
+	}))
+	mux.HandleFunc("/tokens", s.endpoint("text", func(o rslp.Options, text string) interface{} {
+		tokens := o.Tokens(text)
+		if tokens == nil {
+			tokens = []rslp.Token{}
+		}
+		return tokens
+	}))
+	mux.HandleFunc("/explain", s.endpoint("word", func(o rslp.Options, word string) interface{} {
+		return o.Explain(word)
+	}))
+	mux.HandleFunc("/healthz", s.health)
+	return mux
+}
+
+// httpError is an error answered with its status.
+type httpError struct {
+	status int
+	msg    string
+}
+
+func (e *httpError) Error() string {
+	return e.msg
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &httpError{http.StatusBadRequest, fmt.Sprintf(format, args...)}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if e, ok := err.(*httpError); ok {
+		status = e.status
+	}
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+// endpoint returns the handler of an endpoint taking a single input in the
+// field named input, or a batch in the field named input+"s", and answering
+// with the results of f.
+func (s *server) endpoint(input string, f func(o rslp.Options, input string) interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeError(w, &httpError{http.StatusMethodNotAllowed, "method not allowed"})
+			return
+		}
+
+		fields, err := s.readRequest(w, r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		o, err := s.options(fields["options"])
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		delete(fields, "options")
+
+		single, batch := fields[input], fields[input+"s"]
+		switch {
+		case len(fields) != 1 || single == nil && batch == nil:
+			writeError(w, badRequest("want a single %q or %q field besides the options", input, input+"s"))
+		case single != nil:
+			var in string
+			if err := json.Unmarshal(single, &in); err != nil {
+				writeError(w, badRequest("%q is not a string", input))
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{"result": f(o, in)})
+		default:
+			var in []string
+			if err := json.Unmarshal(batch, &in); err != nil {
+				writeError(w, badRequest("%q is not a list of strings", input+"s"))
+				return
+			}
+			if len(in) > s.maxBatch {
+				writeError(w, &httpError{http.StatusRequestEntityTooLarge, fmt.Sprintf("batch of %d inputs over the limit of %d", len(in), s.maxBatch)})
+				return
+			}
+			results := make([]interface{}, len(in))
+			for i, x := range in {
+				results[i] = f(o, x)
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{"results": results})
+		}
+	}
+}
+
+// readRequest reads the fields of the JSON object of the request body. The
+// body is capped by http.MaxBytesReader, which stops the server from reading
+// the rest of a body over the limit.
+func (s *server) readRequest(w http.ResponseWriter, r *http.Request) (map[string]json.RawMessage, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxBytes))
+	if err != nil {
+		// the reader fails after reading exactly the limit.
+		if int64(len(body)) == s.maxBytes {
+			return nil, &httpError{http.StatusRequestEntityTooLarge, fmt.Sprintf("request over the limit of %d bytes", s.maxBytes)}
+		}
+		return nil, badRequest("reading the request: %v", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil || fields == nil {
+		return nil, badRequest("the request is not a JSON object")
+	}
+	return fields, nil
+}
+
+// options returns the options of the server overridden by those of the
+// request, if any.
+func (s *server) options(raw json.RawMessage) (rslp.Options, error) {
+	c := s.base
+	if raw != nil {
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&c); err != nil {
+			return rslp.Options{}, badRequest("invalid options: %v", err)
+		}
+	}
+	o, err := c.Options(s.rules...)
+	if err != nil {
+		return o, badRequest("%v", err)
+	}
+	return o, nil
+}
+
+// health answers with the rule set of the server, its version and the
+// fingerprint of the options of the server.
+func (s *server) health(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeError(w, &httpError{http.StatusMethodNotAllowed, "method not allowed"})
+		return
+	}
+	o, err := s.base.Options(s.rules...)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	rs := o.Rules
+	if rs == nil {
+		rs = rslp.Portuguese
+	}
+	writeJSON(w, http.StatusOK, map[string]string{
+		"status":      "ok",
+		"rules":       rs.Name(),
+		"version":     rs.Version(),
+		"fingerprint": o.Fingerprint(),
+	})
+}
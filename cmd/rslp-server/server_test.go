@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/knuppe/rslp"
+)
+
+func testServer(t *testing.T, rules string, base rslp.Config) *httptest.Server {
+	s, err := newServer(rules, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.maxBytes, s.maxBatch = 1024, 3
+	ts := httptest.NewServer(s.handler())
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// post posts the body to the path and decodes the JSON answer into v,
+// returning the status.
+func post(t *testing.T, ts *httptest.Server, path, body string, v interface{}) int {
+	resp, err := http.Post(ts.URL+path, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+		t.Fatalf("%s: invalid content type %q", path, ct)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode
+}
+
+func TestStem(t *testing.T) {
+	ts := testServer(t, "portuguese", rslp.Config{})
+
+	var single struct{ Result string }
+	if status := post(t, ts, "/stem", `{"word": "gatinhas"}`, &single); status != http.StatusOK || single.Result != "gat" {
+		t.Fatalf("invalid answer %d %+v", status, single)
+	}
+
+	var batch struct{ Results []string }
+	post(t, ts, "/stem", `{"words": ["gatinhas", "Canções", ""], "options": {"keep_diacritics": true}}`, &batch)
+	if want := []string{"gat", "cançã", ""}; !reflect.DeepEqual(batch.Results, want) {
+		t.Fatalf("invalid stems, want %q (got %q)", want, batch.Results)
+	}
+
+	post(t, ts, "/stem/sentence", `{"texts": ["Os gatos pretos", "As canções"], "options": {"stopwords": "pt-br"}}`, &batch)
+	if want := []string{"gat pret", "canca"}; !reflect.DeepEqual(batch.Results, want) {
+		t.Fatalf("invalid sentences, want %q (got %q)", want, batch.Results)
+	}
+}
+
+func TestTokens(t *testing.T) {
+	ts := testServer(t, "portuguese", rslp.Config{Stopwords: "pt-br"})
+
+	var got struct{ Result []rslp.Token }
+	post(t, ts, "/tokens", `{"text": "Os gatos"}`, &got)
+	want := []rslp.Token{
+		{Text: "Os", Stem: "os", Start: 0, End: 2, Position: 0, Stopword: true},
+		{Text: "gatos", Stem: "gat", Start: 3, End: 8, Position: 1},
+	}
+	if !reflect.DeepEqual(got.Result, want) {
+		t.Fatalf("invalid tokens\nwant %+v\n got %+v", want, got.Result)
+	}
+
+	var empty struct{ Result []rslp.Token }
+	post(t, ts, "/tokens", `{"text": ""}`, &empty)
+	if empty.Result == nil || len(empty.Result) != 0 {
+		t.Fatalf("invalid tokens %+v", empty.Result)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	ts := testServer(t, "portuguese", rslp.Config{})
+
+	var got struct{ Results []rslp.Explanation }
+	post(t, ts, "/explain", `{"words": ["meninas"]}`, &got)
+	if want := (rslp.Options{}).Explain("meninas"); len(got.Results) != 1 || !reflect.DeepEqual(got.Results[0], want) {
+		t.Fatalf("invalid explanation\nwant %+v\n got %+v", want, got.Results)
+	}
+}
+
+func TestHealth(t *testing.T) {
+	ts := testServer(t, "../../testdata/rules/test.yaml", rslp.Config{KeepDiacritics: true})
+
+	resp, err := http.Get(ts.URL + "/healthz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var got map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got["status"] != "ok" || got["rules"] != "test" || len(got["fingerprint"]) != 64 {
+		t.Fatalf("invalid health %v", got)
+	}
+
+	var stem struct{ Result string }
+	post(t, ts, "/stem", `{"word": "chefona"}`, &stem)
+	if stem.Result != "chefão" {
+		t.Fatalf("invalid stem %q", stem.Result)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	ts := testServer(t, "portuguese", rslp.Config{})
+
+	for _, tt := range []struct {
+		path, body string
+		status     int
+	}{
+		{"/stem", `not json`, http.StatusBadRequest},
+		{"/stem", `[]`, http.StatusBadRequest},
+		{"/stem", `{}`, http.StatusBadRequest},
+		{"/stem", `{"text": "gatos"}`, http.StatusBadRequest},
+		{"/stem", `{"word": "a", "words": ["b"]}`, http.StatusBadRequest},
+		{"/stem", `{"word": 1}`, http.StatusBadRequest},
+		{"/stem", `{"words": "a"}`, http.StatusBadRequest},
+		{"/stem", `{"word": "a", "options": {"rules": "klingon"}}`, http.StatusBadRequest},
+		{"/stem", `{"word": "a", "options": {"stopwords": "klingon"}}`, http.StatusBadRequest},
+		{"/stem", `{"word": "a", "options": {"keep_accents": true}}`, http.StatusBadRequest},
+		{"/stem", `{"words": ["a", "b", "c", "d"]}`, http.StatusRequestEntityTooLarge},
+		{"/stem", `{"word": "` + strings.Repeat("a", 2048) + `"}`, http.StatusRequestEntityTooLarge},
+	} {
+		var got struct{ Error string }
+		if status := post(t, ts, tt.path, tt.body, &got); status != tt.status || got.Error == "" {
+			t.Fatalf("%s %s: invalid answer %d %+v, want %d", tt.path, tt.body, status, got, tt.status)
+		}
+	}
+
+	// a body of exactly the limit is read.
+	var stem struct{ Result string }
+	body := `{"word": "` + strings.Repeat("a", 1024-len(`{"word": ""}`)) + `"}`
+	if status := post(t, ts, "/stem", body, &stem); status != http.StatusOK || stem.Result == "" {
+		t.Fatalf("invalid answer %d %+v for a body of %d bytes", status, stem, len(body))
+	}
+
+	for _, path := range []string{"/stem", "/healthz"} {
+		req, _ := http.NewRequest(http.MethodPut, ts.URL+path, nil)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusMethodNotAllowed || resp.Header.Get("Allow") == "" {
+			t.Fatalf("%s: invalid answer %d", path, resp.StatusCode)
+		}
+	}
+}
+
+func TestNewServerErrors(t *testing.T) {
+	for _, tt := range []struct {
+		rules string
+		base  rslp.Config
+	}{
+		{"does-not-exist", rslp.Config{}},
+		{"portuguese", rslp.Config{Stopwords: "klingon"}},
+	} {
+		if _, err := newServer(tt.rules, tt.base); err == nil {
+			t.Fatalf("expected an error for %q %+v", tt.rules, tt.base)
+		}
+	}
+}
+
+func TestHTTPServer(t *testing.T) {
+	srv := httpServer(":0", http.NotFoundHandler(), time.Minute)
+	if srv.ReadHeaderTimeout == 0 || srv.ReadTimeout != time.Minute || srv.WriteTimeout != time.Minute || srv.IdleTimeout == 0 {
+		t.Fatalf("invalid timeouts %v %v %v %v", srv.ReadHeaderTimeout, srv.ReadTimeout, srv.WriteTimeout, srv.IdleTimeout)
+	}
+}
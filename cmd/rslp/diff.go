@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/knuppe/rslp"
+)
+
+// diff stems the words of the files, or of the standard input, with two rule
+// sets and writes the words whose stem changed.
+func diff(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	from := fs.String("old", rslp.Portuguese.Name(), "built-in rule set name or rule file to compare from")
+	to := fs.String("new", "", "built-in rule set name or rule file to compare to")
+	format := fs.String("format", "text", "output format: text or json")
+	output := fs.String("o", "", "output file (default stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *to == "" {
+		return fmt.Errorf("rslp: diff needs the -new rule set")
+	}
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("rslp: unknown diff format %q", *format)
+	}
+
+	old, err := loadRules(*from)
+	if err != nil {
+		return err
+	}
+	updated, err := loadRules(*to)
+	if err != nil {
+		return err
+	}
+	var vocabulary []string
+	err = eachWord(fs.Args(), func(word string) {
+		vocabulary = append(vocabulary, word)
+	})
+	if err != nil {
+		return err
+	}
+	d := rslp.DiffRules(rslp.Options{Rules: old}, rslp.Options{Rules: updated}, vocabulary)
+
+	w, close, err := create(*output, stdout)
+	if err != nil {
+		return err
+	}
+	if *format == "json" {
+		err = d.WriteJSON(w)
+	} else {
+		err = d.WriteText(w)
+	}
+	if err != nil {
+		close()
+		return err
+	}
+	return close()
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	dir := t.TempDir()
+	corpus := filepath.Join(dir, "corpus.txt")
+	if err := os.WriteFile(corpus, []byte("Os pelos e o pelo.\nAs casas.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pairs := filepath.Join(dir, "pairs.txt")
+	if err := os.WriteFile(pairs, []byte("pelos pelo !=\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	rules := filepath.Join(dir, "rules.rslp")
+	if err := suggest([]string{"-o", rules, pairs}, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := diff([]string{"-new", rules, corpus}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"1 of 7 words changed, 0 classes merged, 1 split\n",
+		"+Plural/10 (-s): 1 word\n\tpelos: pel -> pelos\n",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Fatalf("missing %q in\n%s", want, buf.String())
+		}
+	}
+
+	buf.Reset()
+	if err := diff([]string{"-new", "lucene-portuguese", "-format", "json", corpus}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		Words int `json:"words"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Words != 7 {
+		t.Fatalf("invalid number of words %d", got.Words)
+	}
+}
+
+func TestDiffErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{},
+		{"-new", "galician", "-format", "xml"},
+		{"-new", "does-not-exist"},
+		{"-old", "does-not-exist", "-new", "galician"},
+		{"-new", "galician", "does-not-exist.txt"},
+	} {
+		if err := diff(args, &bytes.Buffer{}); err == nil {
+			t.Fatalf("diff %q: expected an error", args)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/knuppe/rslp"
+)
+
+// fingerprint writes the name, version and fingerprint of the rule sets, or
+// of the built-in ones when none is given.
+func fingerprint(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("fingerprint", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	names := fs.Args()
+	if len(names) == 0 {
+		for _, rs := range rslp.BuiltinRuleSets() {
+			names = append(names, rs.Name())
+		}
+		sort.Strings(names)
+	}
+	for _, name := range names {
+		rs, err := loadRules(name)
+		if err != nil {
+			return err
+		}
+		version := rs.Version()
+		if version == "" {
+			version = "-"
+		}
+		fmt.Fprintf(stdout, "%s\t%s\t%s\n", rs.Name(), version, rs.Fingerprint())
+	}
+	return nil
+}
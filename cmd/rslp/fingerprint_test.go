@@ -0,0 +1,35 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/knuppe/rslp"
+)
+
+func TestFingerprint(t *testing.T) {
+	var buf bytes.Buffer
+	if err := fingerprint(nil, &buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != len(rslp.BuiltinRuleSets()) {
+		t.Fatalf("invalid output\n%s", buf.String())
+	}
+	if want := "galician\t1\t" + rslp.Galician.Fingerprint(); lines[0] != want {
+		t.Fatalf("invalid line, want %q (got %q)", want, lines[0])
+	}
+
+	buf.Reset()
+	if err := fingerprint([]string{"../../testdata/rules/test.yaml"}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "test\t-\t") {
+		t.Fatalf("invalid output %q", buf.String())
+	}
+
+	if err := fingerprint([]string{"does-not-exist"}, &bytes.Buffer{}); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/knuppe/rslp"
+)
+
+// graph writes the step graph of a rule set.
+func graph(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("graph", flag.ContinueOnError)
+	rules := fs.String("rules", rslp.Portuguese.Name(), "built-in rule set name or rule file")
+	format := fs.String("format", "dot", "output format: dot or mermaid")
+	counts := fs.Bool("counts", false, "add the number of rules to the steps")
+	perRule := fs.Bool("per-rule", false, "add an edge from each step to each of its rules")
+	output := fs.String("o", "", "output file (default stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	rs, err := loadRules(*rules)
+	if err != nil {
+		return err
+	}
+
+	opts := rslp.GraphOptions{RuleCounts: *counts, Rules: *perRule}
+	write := rs.WriteDOT
+	switch *format {
+	case "dot":
+	case "mermaid":
+		write = rs.WriteMermaid
+	default:
+		return fmt.Errorf("rslp: unknown graph format %q", *format)
+	}
+
+	w, close, err := create(*output, stdout)
+	if err != nil {
+		return err
+	}
+	if err := write(w, opts); err != nil {
+		close()
+		return err
+	}
+	return close()
+}
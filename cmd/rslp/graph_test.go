@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGraph(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{nil, `digraph "portuguese" {`},
+		{[]string{"-format", "mermaid", "-rules", "galician"}, "flowchart TD\n"},
+		{[]string{"-counts", "-rules", "lucene-portuguese"}, `[label="Plural (11 rules)"]`},
+		{[]string{"-per-rule"}, `"Plural/0" [label="-ns → m", shape=plaintext];`},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := graph(tt.args, &buf); err != nil {
+			t.Fatalf("graph %q: %v", tt.args, err)
+		}
+		if !strings.Contains(buf.String(), tt.want) {
+			t.Fatalf("graph %q: missing %q in\n%s", tt.args, tt.want, buf.String())
+		}
+	}
+}
+
+func TestGraphFile(t *testing.T) {
+	dir := t.TempDir()
+	rules := filepath.Join(dir, "custom.rslp")
+	if err := os.WriteFile(rules, []byte("{ \"Vowel\", 0, 1, {},\n{\"o\",3}};\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(dir, "custom.dot")
+
+	if err := graph([]string{"-rules", rules, "-o", out}, nil); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(got), `"Vowel" -> "end";`) {
+		t.Fatalf("invalid graph\n%s", got)
+	}
+}
+
+func TestGraphErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"-format", "svg"},
+		{"-rules", "does-not-exist.rslp"},
+		{"-unknown"},
+	} {
+		if err := graph(args, &bytes.Buffer{}); err == nil {
+			t.Fatalf("graph %q: expected an error", args)
+		}
+	}
+}
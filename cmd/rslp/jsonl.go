@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/knuppe/rslp"
+)
+
+// jsonlRequest is a line read by the jsonl command.
+type jsonlRequest struct {
+	ID      json.RawMessage `json:"id,omitempty"`
+	Op      string          `json:"op,omitempty"`
+	Text    *string         `json:"text"`
+	Options json.RawMessage `json:"options,omitempty"`
+}
+
+// jsonlResponse is a line written by the jsonl command.
+type jsonlResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result interface{}     `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// jsonlOps are the operations of the jsonl command, by name.
+var jsonlOps = map[string]func(o rslp.Options, text string) interface{}{
+	"stem":     func(o rslp.Options, text string) interface{} { return o.Stem(text) },
+	"sentence": func(o rslp.Options, text string) interface{} { return o.StemSentence(text) },
+	"tokens": func(o rslp.Options, text string) interface{} {
+		tokens := o.Tokens(text)
+		if tokens == nil {
+			tokens = []rslp.Token{}
+		}
+		return tokens
+	},
+	"explain": func(o rslp.Options, text string) interface{} { return o.Explain(text) },
+}
+
+// jsonl reads JSON requests from the standard input, one per line, and
+// writes a JSON response for each of them, in order, as soon as it is
+// ready. A request is an object such as
+//
+//	{"id": 1, "op": "sentence", "text": "Os gatos.", "options": {"stopwords": "pt-br"}}
+//
+// where op is "stem", "sentence" (the default), "tokens" or "explain" and
+// options are the fields of rslp.Config overriding the flags. The response
+// holds the id of the request along with either its result or an error.
+func jsonl(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("jsonl", flag.ContinueOnError)
+	rules := fs.String("rules", rslp.Portuguese.Name(), "built-in rule set name or rule file")
+	stopwords := fs.String("stopwords", "", "built-in stopword list: pt-br or pt-pt")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	rs, err := loadRules(*rules)
+	if err != nil {
+		return err
+	}
+	base := rslp.Config{Rules: rs.Name(), Stopwords: *stopwords}
+	if _, err := base.Options(rs); err != nil {
+		return err
+	}
+	return serveJSONL(os.Stdin, stdout, base, rs)
+}
+
+// serveJSONL answers the requests read from r, writing the responses to w.
+func serveJSONL(r io.Reader, w io.Writer, base rslp.Config, rs *rslp.RuleSet) error {
+	in := bufio.NewReader(r)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for {
+		line, err := in.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			if err := enc.Encode(answerJSONL(line, base, rs)); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// answerJSONL answers a single request line.
+func answerJSONL(line []byte, base rslp.Config, rs *rslp.RuleSet) jsonlResponse {
+	var req jsonlRequest
+	if err := json.Unmarshal(line, &req); err != nil {
+		return jsonlResponse{ID: json.RawMessage("null"), Error: fmt.Sprintf("invalid request: %v", err)}
+	}
+	resp := jsonlResponse{ID: req.ID}
+	if resp.ID == nil {
+		resp.ID = json.RawMessage("null")
+	}
+
+	if req.Op == "" {
+		req.Op = "sentence"
+	}
+	op, ok := jsonlOps[req.Op]
+	if !ok {
+		resp.Error = fmt.Sprintf("unknown op %q", req.Op)
+		return resp
+	}
+	if req.Text == nil {
+		resp.Error = "missing text"
+		return resp
+	}
+
+	c := base
+	if req.Options != nil {
+		dec := json.NewDecoder(bytes.NewReader(req.Options))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&c); err != nil {
+			resp.Error = fmt.Sprintf("invalid options: %v", err)
+			return resp
+		}
+	}
+	o, err := c.Options(rs)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	resp.Result = op(o, *req.Text)
+	return resp
+}
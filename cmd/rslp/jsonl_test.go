@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/knuppe/rslp"
+)
+
+func TestJSONL(t *testing.T) {
+	input := strings.Join([]string{
+		`{"id": 1, "op": "stem", "text": "gatinhas"}`,
+		`{"id": "b", "text": "Os gatos correram."}`,
+		``,
+		`not json`,
+		`{"id": 3, "op": "lemma", "text": "gatos"}`,
+		`{"id": 4}`,
+		`{"id": 5, "text": "Os gatos", "options": {"stopwords": "pt-br"}}`,
+		`{"id": 6, "text": "gatos", "options": {"stopwords": "en"}}`,
+		`{"id": 7, "text": "gatos", "options": {"unknown": true}}`,
+		`{"id": 8, "op": "tokens", "text": ""}`,
+		`{"text": "canções", "options": {"keep_diacritics": true}}`,
+	}, "\n")
+	want := strings.Join([]string{
+		`{"id":1,"result":"gat"}`,
+		`{"id":"b","result":"os gat correram."}`,
+		`{"id":null,"error":"invalid request: invalid character 'o' in literal null (expecting 'u')"}`,
+		`{"id":3,"error":"unknown op \"lemma\""}`,
+		`{"id":4,"error":"missing text"}`,
+		`{"id":5,"result":"gat"}`,
+		`{"id":6,"error":"rslp: unknown stopword list \"en\""}`,
+		`{"id":7,"error":"invalid options: json: unknown field \"unknown\""}`,
+		`{"id":8,"result":[]}`,
+		`{"id":null,"result":"cançã"}`,
+	}, "\n") + "\n"
+
+	var buf bytes.Buffer
+	if err := serveJSONL(strings.NewReader(input), &buf, rslp.Config{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != want {
+		t.Fatalf("invalid output\n%s\nwant\n%s", buf.String(), want)
+	}
+}
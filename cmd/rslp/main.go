@@ -0,0 +1,89 @@
+// Command rslp inspects the rule sets of the RSLP stemmer.
+//
+// Usage:
+//
+//	rslp <command> [flags]
+//
+// The commands are:
+//
+//	diff	lists the words whose stem differs between two rule sets
+//	fingerprint	writes the version and fingerprint of rule sets
+//	graph	writes the step graph of a rule set in DOT or Mermaid
+//	jsonl	stems the texts of JSON requests read line by line
+//	stats	counts how often each rule fires on a corpus, as CSV or JSON
+//	suggest	suggests exceptions and min lengths from labeled word pairs
+//	verify	checks the examples of structured rule files
+//
+// Run "rslp <command> -h" for the flags of a command.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/knuppe/rslp"
+)
+
+// commands are the subcommands of rslp, by name.
+var commands = map[string]func(args []string, stdout io.Writer) error{
+	"diff":        diff,
+	"fingerprint": fingerprint,
+	"graph":       graph,
+	"jsonl":       jsonl,
+	"stats":       stats,
+	"suggest":     suggest,
+	"verify":      verify,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "rslp: unknown command %q\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+	if err := cmd(os.Args[2:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintln(os.Stderr, "usage: rslp <command> [flags]")
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "\t%s\n", name)
+	}
+}
+
+// loadRules returns the built-in rule set with the given name, or loads the
+// rule file at that path.
+func loadRules(name string) (*rslp.RuleSet, error) {
+	if rs := rslp.BuiltinRules(name); rs != nil {
+		return rs, nil
+	}
+	return rslp.LoadRulesFile(name)
+}
+
+// create returns the file at path, or stdout when path is empty or "-".
+func create(path string, stdout io.Writer) (io.Writer, func() error, error) {
+	if path == "" || path == "-" {
+		return stdout, func() error { return nil }, nil
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, f.Close, nil
+}
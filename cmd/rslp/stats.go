@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/knuppe/rslp"
+)
+
+// stats stems the words of the files, or of the standard input, and writes
+// how often each rule fired.
+func stats(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	rules := fs.String("rules", rslp.Portuguese.Name(), "built-in rule set name or rule file")
+	format := fs.String("format", "csv", "output format: csv or json")
+	output := fs.String("o", "", "output file (default stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format != "csv" && *format != "json" {
+		return fmt.Errorf("rslp: unknown stats format %q", *format)
+	}
+
+	rs, err := loadRules(*rules)
+	if err != nil {
+		return err
+	}
+	st := rslp.NewStats(rslp.Options{Rules: rs})
+	err = eachWord(fs.Args(), func(word string) {
+		st.Stem(word)
+	})
+	if err != nil {
+		return err
+	}
+
+	w, close, err := create(*output, stdout)
+	if err != nil {
+		return err
+	}
+	if *format == "json" {
+		err = st.WriteJSON(w)
+	} else {
+		err = st.WriteCSV(w)
+	}
+	if err != nil {
+		close()
+		return err
+	}
+	return close()
+}
+
+// words splits the text into words, without the punctuation around them.
+var words = rslp.NewAnalyzer(rslp.PunctuationFilter())
+
+// eachWord calls f with each word of the files, or of the standard input
+// when there are no files.
+func eachWord(files []string, f func(word string)) error {
+	read := func(r io.Reader) error {
+		s := bufio.NewScanner(r)
+		s.Buffer(nil, 1<<20)
+		for s.Scan() {
+			for _, word := range words.Terms(s.Text()) {
+				f(word)
+			}
+		}
+		return s.Err()
+	}
+
+	if len(files) == 0 {
+		return read(os.Stdin)
+	}
+	for _, name := range files {
+		file, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		err = read(file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("rslp: reading %s: %w", name, err)
+		}
+	}
+	return nil
+}
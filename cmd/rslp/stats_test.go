@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	corpus := filepath.Join(t.TempDir(), "corpus.txt")
+	if err := os.WriteFile(corpus, []byte("Os lápis, as casas\ne os pães.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := stats([]string{corpus}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"step,rule,suffix,replacement,applied,blocked_by_exception,blocked_by_length,exceptions\n",
+		"Plural,2,ães,ão,1,0,0,\n",
+		"Plural,10,s,,1,1,0,lápis:1\n",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Fatalf("missing %q in\n%s", want, buf.String())
+		}
+	}
+
+	buf.Reset()
+	if err := stats([]string{"-format", "json", "-rules", "lucene-portuguese", corpus}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		Rules string `json:"rules"`
+		Words int    `json:"words"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Rules != "lucene-portuguese" || got.Words != 7 {
+		t.Fatalf("invalid stats %+v", got)
+	}
+}
+
+func TestStatsErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"-format", "xml"},
+		{"does-not-exist.txt"},
+	} {
+		if err := stats(args, &bytes.Buffer{}); err == nil {
+			t.Fatalf("stats %q: expected an error", args)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/knuppe/rslp"
+)
+
+// suggest reads labeled word pairs and writes the rule file improved by the
+// changes suggested for them, or lists the suggestions with -list.
+func suggest(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("suggest", flag.ContinueOnError)
+	rules := fs.String("rules", rslp.Portuguese.Name(), "built-in rule set name or rule file")
+	list := fs.Bool("list", false, "list the ranked suggestions instead of writing a rule file")
+	output := fs.String("o", "", "output file (default stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("rslp: usage: rslp suggest [flags] pairs.txt")
+	}
+
+	rs, err := loadRules(*rules)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	pairs, err := rslp.ReadPairs(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	if *list {
+		for _, s := range rslp.Suggest(rslp.Options{Rules: rs}, pairs) {
+			fmt.Fprintln(&b, s)
+		}
+	} else {
+		improved, applied := rslp.Improve(rslp.Options{Rules: rs}, pairs)
+		fmt.Fprintf(&b, "# %d suggestions applied to %s for %s:\n", len(applied), rs.Name(), fs.Arg(0))
+		for _, s := range applied {
+			fmt.Fprintf(&b, "#\t%s\n", s)
+		}
+		if err := improved.WriteRules(&b); err != nil {
+			return err
+		}
+	}
+
+	w, close, err := create(*output, stdout)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		close()
+		return err
+	}
+	return close()
+}
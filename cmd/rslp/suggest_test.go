@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/knuppe/rslp"
+)
+
+func TestSuggest(t *testing.T) {
+	dir := t.TempDir()
+	pairs := filepath.Join(dir, "pairs.txt")
+	if err := os.WriteFile(pairs, []byte("lápis lápis\npelos pelo !=\ngatos gato\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := suggest([]string{"-list", pairs}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), `Plural/10 (-s): add exception "pelos" (fixes 1, breaks 0)`) {
+		t.Fatalf("invalid suggestions\n%s", buf.String())
+	}
+
+	rules := filepath.Join(dir, "rules.rslp")
+	if err := suggest([]string{"-o", rules, pairs}, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	rs, err := loadRules(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := rslp.Options{Rules: rs}
+	if o.Stem("pelos") == o.Stem("pelo") || o.Stem("gatos") != o.Stem("gato") {
+		t.Fatal("the suggested rule file stems the pairs wrongly")
+	}
+}
+
+func TestSuggestErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{},
+		{"does-not-exist.txt"},
+		{"-rules", "does-not-exist", "pairs.txt"},
+	} {
+		if err := suggest(args, &bytes.Buffer{}); err == nil {
+			t.Fatalf("suggest %q: expected an error", args)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+)
+
+// verify checks the examples and counterexamples of the rule files, writing
+// those that fail.
+func verify(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf("rslp: usage: rslp verify rules.yaml...")
+	}
+
+	failed := 0
+	for _, name := range fs.Args() {
+		rs, err := loadRules(name)
+		if err != nil {
+			return err
+		}
+		for _, f := range rs.Verify() {
+			fmt.Fprintf(stdout, "%s: %s\n", name, f)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("rslp: %d examples failed", failed)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	var buf bytes.Buffer
+	if err := verify([]string{"../../testdata/rules/test.yaml", "../../testdata/rules/test.json", "portuguese"}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("unexpected output %q", buf.String())
+	}
+
+	rules := filepath.Join(t.TempDir(), "rules.yml")
+	yaml := "steps:\n  - name: Plural\n    rules:\n      - suffix: s\n        examples:\n          - {input: casas, output: casas}\n"
+	if err := os.WriteFile(rules, []byte(yaml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := verify([]string{rules}, &buf); err == nil {
+		t.Fatal("expected an error for a failing example")
+	}
+	if want := rules + `: Plural/0: example "casas" -> "casas" (got "casa")` + "\n"; buf.String() != want {
+		t.Fatalf("invalid output\nwant %q\n got %q", want, buf.String())
+	}
+}
+
+func TestVerifyErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{},
+		{"does-not-exist.yaml"},
+	} {
+		if err := verify(args, &bytes.Buffer{}); err == nil {
+			t.Fatalf("verify %q: expected an error", args)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Command rslpgen generates the Go source of a compiled stemmer for a rule
+// set, where each step is a function switching on the last byte of the word
+// and the flow between the steps is hard-wired. It is meant to be run by go
+// generate:
+//
+//	//go:generate go run github.com/knuppe/rslp/cmd/rslpgen -rules portuguese -name Portuguese -o portuguese.go
+//
+// The rules are a built-in rule set name or a rule file, in the RSLP format
+// or in JSON or YAML for the .json, .yaml and .yml extensions. The package
+// of the generated file defaults to $GOPACKAGE, set by go generate.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/knuppe/rslp"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("rslpgen", flag.ContinueOnError)
+	rules := fs.String("rules", rslp.Portuguese.Name(), "built-in rule set name or rule file")
+	pkg := fs.String("package", os.Getenv("GOPACKAGE"), "package name of the generated file")
+	name := fs.String("name", "Portuguese", "name of the variable holding the compiled rule set")
+	output := fs.String("o", "", "output file (default stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("rslpgen: unexpected arguments %q", fs.Args())
+	}
+
+	var err error
+	rs := rslp.BuiltinRules(*rules)
+	if rs == nil {
+		if rs, err = rslp.LoadRulesFile(*rules); err != nil {
+			return err
+		}
+	}
+	var buf bytes.Buffer
+	err = rs.WriteGo(&buf, rslp.GoOptions{
+		Package: *pkg,
+		Name:    *name,
+		Command: "rslpgen " + strings.Join(args, " "),
+	})
+	if err != nil {
+		return err
+	}
+
+	if *output == "" || *output == "-" {
+		_, err = stdout.Write(buf.Bytes())
+		return err
+	}
+	return os.WriteFile(*output, buf.Bytes(), 0o644)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	var buf bytes.Buffer
+	if err := run([]string{"-package", "stemmer", "-rules", "galician", "-name", "Galician"}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"// Code generated by rslpgen -package stemmer -rules galician -name Galician; DO NOT EDIT.\n",
+		"var Galician = rslp.Galician.Compiled(galicianRun)\n",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Fatalf("missing %q in\n%s", want, buf.String())
+		}
+	}
+
+	output := filepath.Join(t.TempDir(), "portuguese.go")
+	if err := run([]string{"-package", "stemmer", "-o", output}, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte("var Portuguese = rslp.Portuguese.Compiled(portugueseRun)\n")) {
+		t.Fatalf("invalid output\n%s", data)
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"-package", ""},
+		{"-package", "stemmer", "-rules", "does-not-exist"},
+		{"-package", "stemmer", "extra"},
+	} {
+		if err := run(args, &bytes.Buffer{}); err == nil {
+			t.Fatalf("rslpgen %q: expected an error", args)
+		}
+	}
+}
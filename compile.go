@@ -0,0 +1,425 @@
+package rslp
+
+import (
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Compiled returns a copy of the rule set whose steps are run by run, a
+// function generated from the rule set by WriteGo. The function is given
+// lowercase words and must return the same words as the steps of the rule
+// set. Tracing, as done by NewStats, still runs the steps themselves.
+func (rs *RuleSet) Compiled(run func(word string) string) *RuleSet {
+	c := *rs
+	c.compiled = run
+	return &c
+}
+
+// GoOptions configures the Go source written by WriteGo.
+type GoOptions struct {
+	// Package is the name of the package of the generated file.
+	Package string
+
+	// Name is the name of the exported variable holding the compiled rule
+	// set, such as "Portuguese".
+	Name string
+
+	// Command is the command that generated the file, mentioned in its
+	// header.
+	Command string
+}
+
+// builtinRuleSets are the Go expressions of the built-in rule sets, for the
+// generated code.
+var builtinRuleSets = map[*RuleSet]string{
+	Portuguese:         "rslp.Portuguese",
+	PortugueseExtended: "rslp.PortugueseExtended",
+	LucenePortuguese:   "rslp.LucenePortuguese",
+	Galician:           "rslp.Galician",
+}
+
+// WriteGo writes the Go source of a compiled stemmer for the rule set,
+// declaring a variable with the rule set returned by Compiled. Each step is
+// compiled to a function switching on the last byte of the word, with the
+// exceptions of each rule looked up in a perfect hash table, and the flow
+// between the steps is compiled to jumps. A rule set other than the built-in
+// ones is embedded as a rule file, which can't hold the semantics of Lucene.
+func (rs *RuleSet) WriteGo(w io.Writer, opts GoOptions) error {
+	if !token.IsIdentifier(opts.Package) {
+		return fmt.Errorf("rslp: invalid package name %q", opts.Package)
+	}
+	if !token.IsIdentifier(opts.Name) || !token.IsExported(opts.Name) {
+		return fmt.Errorf("rslp: invalid variable name %q", opts.Name)
+	}
+
+	g := &goWriter{rs: rs, prefix: strings.ToLower(opts.Name[:1]) + opts.Name[1:]}
+
+	expr, builtin := builtinRuleSets[rs]
+	if !builtin {
+		if rs.lucene {
+			return fmt.Errorf("rslp: the rule set %q can't be embedded as a rule file", rs.name)
+		}
+		var rules strings.Builder
+		if err := rs.WriteRules(&rules); err != nil {
+			return err
+		}
+		g.printf("// %sRules is the rule file of the %q rule set.\n", g.prefix, rs.name)
+		g.printf("const %sRules = %s\n\n", g.prefix, quoteGo(rules.String()))
+		expr = fmt.Sprintf("%sMustLoad(%q, %sRules)", g.prefix, rs.name, g.prefix)
+		g.printf("func %sMustLoad(name, rules string) *rslp.RuleSet {\n", g.prefix)
+		g.printf("rs, err := rslp.LoadRules(name, strings.NewReader(rules))\n")
+		g.printf("if err != nil {\npanic(err)\n}\nreturn rs\n}\n\n")
+	}
+	g.run()
+
+	var b strings.Builder
+	if opts.Command != "" {
+		fmt.Fprintf(&b, "// Code generated by %s; DO NOT EDIT.\n\n", opts.Command)
+	} else {
+		b.WriteString("// Code generated by rslp.WriteGo; DO NOT EDIT.\n\n")
+	}
+	fmt.Fprintf(&b, "package %s\n\n", opts.Package)
+	b.WriteString("import (\n")
+	if !builtin || g.usesStrings {
+		b.WriteString("\"strings\"\n")
+	}
+	if rs.lucene {
+		b.WriteString("\"unicode/utf8\"\n")
+	}
+	b.WriteString("\n\"github.com/knuppe/rslp\"\n)\n\n")
+	fmt.Fprintf(&b, "// %s is the %q rule set with its steps compiled.\n", opts.Name, rs.name)
+	fmt.Fprintf(&b, "var %s = %s.Compiled(%sRun)\n\n", opts.Name, expr, g.prefix)
+	b.WriteString(g.b.String())
+
+	src, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return fmt.Errorf("rslp: formatting the generated code: %w", err)
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// goWriter writes the code of a compiled rule set.
+type goWriter struct {
+	rs     *RuleSet
+	prefix string
+	b      strings.Builder
+
+	usesStrings bool
+	usesHash    bool
+}
+
+func (g *goWriter) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.b, format, args...)
+}
+
+// run writes the function running the steps, and the functions of the steps
+// it reaches.
+func (g *goWriter) run() {
+	rs := g.rs
+	ids := make(map[string]int, len(rs.order))
+	for i, name := range rs.order {
+		ids[name] = i
+	}
+
+	// the steps reached from the start, in the order of the rule set.
+	reached := map[string]bool{}
+	var reach func(name string)
+	reach = func(name string) {
+		if rs.steps[name] == nil || reached[name] {
+			return
+		}
+		reached[name] = true
+		reach(rs.steps[name].stepPass)
+		reach(rs.steps[name].stepFail)
+	}
+	reach(rs.start)
+
+	next := func(name string) string {
+		if rs.steps[name] == nil {
+			return "return word"
+		}
+		return fmt.Sprintf("goto step%d", ids[name])
+	}
+
+	var body strings.Builder
+	usesOK := false
+	if rs.steps[rs.start] != nil {
+		fmt.Fprintf(&body, "goto step%d\n", ids[rs.start])
+	}
+	for i, name := range rs.order {
+		if !reached[name] {
+			continue
+		}
+		s := rs.steps[name]
+		fmt.Fprintf(&body, "\nstep%d: // %s\n", i, name)
+		fmt.Fprintf(&body, "if n == %d {\nreturn word\n}\nn++\n", maxStepRuns)
+		if s.stepPass == s.stepFail || rs.steps[s.stepPass] == nil && rs.steps[s.stepFail] == nil {
+			fmt.Fprintf(&body, "word, _ = %sStep%d(word)\n", g.prefix, i)
+			fmt.Fprintf(&body, "%s\n", next(s.stepPass))
+			continue
+		}
+		usesOK = true
+		fmt.Fprintf(&body, "if word, ok = %sStep%d(word); ok {\n%s\n}\n", g.prefix, i, next(s.stepPass))
+		fmt.Fprintf(&body, "%s\n", next(s.stepFail))
+	}
+
+	g.printf("// %sRun runs the steps of the rule set on a lowercase word.\n", g.prefix)
+	g.printf("func %sRun(word string) string {\n", g.prefix)
+	if usesOK {
+		g.printf("var ok bool\n")
+	}
+	g.printf("n := 0\n")
+	g.printf("%s", body.String())
+	if rs.steps[rs.start] == nil {
+		g.printf("return word\n")
+	}
+	g.printf("}\n")
+
+	for i, name := range rs.order {
+		if reached[name] {
+			g.step(i, name)
+		}
+	}
+	if g.usesHash {
+		g.printf("\n// %sHash is the FNV-1a hash of s, seeded with seed.\n", g.prefix)
+		g.printf("func %sHash(seed uint32, s string) uint32 {\n", g.prefix)
+		g.printf("h := 2166136261 ^ seed\nfor i := 0; i < len(s); i++ {\nh ^= uint32(s[i])\nh *= 16777619\n}\nreturn h\n}\n")
+	}
+}
+
+// step writes the function of the step with the given index and name, which
+// returns the resulting word and whether the step passed.
+func (g *goWriter) step(i int, name string) {
+	rs := g.rs
+	s := rs.steps[name]
+
+	g.printf("\n// %sStep%d runs the %q step.\n", g.prefix, i, name)
+	g.printf("func %sStep%d(word string) (string, bool) {\n", g.prefix, i)
+	length := "len(word)"
+	if rs.lucene {
+		g.printf("n := utf8.RuneCountInString(word)\n")
+		length = "n"
+	}
+	if s.minLength > 0 {
+		g.printf("if %s < %d {\nreturn word, false\n}\n", length, s.minLength)
+	}
+	checked := s.minLength > 0
+	if len(s.endWords) > 0 && !contains(s.endWords, "") {
+		checked = true
+		g.usesStrings = true
+		conds := make([]string, len(s.endWords))
+		for j, e := range s.endWords {
+			conds[j] = fmt.Sprintf("!strings.HasSuffix(word, %s)", quoteGo(e))
+		}
+		g.printf("if %s {\nreturn word, false\n}\n", strings.Join(conds, " && "))
+	}
+	if !checked {
+		g.printf("if word == \"\" {\nreturn word, false\n}\n")
+	}
+
+	// the rules whose suffixes end with different bytes never match the
+	// same word, so grouping them by their last byte keeps their order.
+	var last []byte
+	groups := map[byte][]int{}
+	for j, r := range s.rules {
+		if !utf8.RuneStart(r.suffix[0]) {
+			continue // never cuts a rune in half, so never applies
+		}
+		c := r.suffix[len(r.suffix)-1]
+		if groups[c] == nil {
+			last = append(last, c)
+		}
+		groups[c] = append(groups[c], j)
+	}
+	sort.Slice(last, func(a, b int) bool { return last[a] < last[b] })
+
+	var tables strings.Builder
+	if len(last) > 0 {
+		g.printf("switch word[len(word)-1] {\n")
+	}
+	for _, c := range last {
+		g.printf("case %s:\n", quoteByte(c))
+		for _, j := range groups[c] {
+			r := &s.rules[j]
+
+			conds := []string{}
+			if len(r.suffix) > 1 {
+				g.usesStrings = true
+				conds = append(conds, fmt.Sprintf("strings.HasSuffix(word, %s)", quoteGo(r.suffix)))
+			}
+			if rs.lucene {
+				chars := utf8.RuneCountInString(r.suffix)
+				if min := chars + max(r.minLength, 1); min > 1 {
+					conds = append(conds, fmt.Sprintf("n >= %d", min))
+				}
+			} else if min := r.minLength + len(r.suffix); min > 1 {
+				conds = append(conds, fmt.Sprintf("len(word) >= %d", min))
+			}
+
+			exc, never := g.exceptions(&tables, i, j, r, s.entireWord)
+			if never {
+				g.printf("// -%s never applies, an exception matches every word.\n", r.suffix)
+				continue
+			}
+			if exc != "" {
+				conds = append(conds, exc)
+			}
+			cond := "true"
+			if len(conds) > 0 {
+				cond = strings.Join(conds, " && ")
+			}
+
+			after := fmt.Sprintf("word[:len(word)-%d]", len(r.suffix))
+			if r.replacement != "" {
+				after += " + " + quoteGo(r.replacement)
+			}
+			passed := "true"
+			if rs.lucene && utf8.RuneCountInString(r.replacement) == utf8.RuneCountInString(r.suffix) {
+				passed = "false" // the length of the word didn't change
+			}
+			g.printf("if %s {\nreturn %s, %s\n}\n", cond, after, passed)
+		}
+	}
+	if len(last) > 0 {
+		g.printf("}\n")
+	}
+	g.printf("return word, false\n}\n")
+	g.printf("%s", tables.String())
+}
+
+// exceptions writes the perfect hash table of the exceptions of the rule to
+// tables, and returns the condition excluding them. It reports whether the
+// exceptions match every word.
+func (g *goWriter) exceptions(tables *strings.Builder, i, j int, r *rule, entireWord bool) (string, bool) {
+	seen := map[string]bool{}
+	var words []string
+	for _, e := range r.exceptions {
+		if e == "" {
+			if !entireWord {
+				return "", true
+			}
+			continue // no word reaching the rule is empty
+		}
+		if !seen[e] {
+			seen[e] = true
+			words = append(words, e)
+		}
+	}
+	if len(words) == 0 {
+		return "", false
+	}
+
+	g.usesHash = true
+	table, seed := perfectHash(words)
+	name := fmt.Sprintf("%sExceptions%d_%d", g.prefix, i, j)
+	fmt.Fprintf(tables, "\n// %s are the exceptions of the -%s rule, by their hash.\n", name, r.suffix)
+	fmt.Fprintf(tables, "var %s = [%d]string{\n", name, len(table))
+	for k, e := range table {
+		if e != "" {
+			fmt.Fprintf(tables, "%d: %s,\n", k, quoteGo(e))
+		}
+	}
+	tables.WriteString("}\n")
+
+	lookup := func(key string) string {
+		return fmt.Sprintf("%s[%sHash(%d, %s)&%d] != %s", name, g.prefix, seed, key, len(table)-1, key)
+	}
+	if entireWord {
+		return lookup("word"), false
+	}
+
+	// an exception matches the end of the word, so the ends of the word of
+	// the lengths of the exceptions are looked up.
+	var lengths []int
+	for _, e := range words {
+		if !containsInt(lengths, len(e)) {
+			lengths = append(lengths, len(e))
+		}
+	}
+	sort.Ints(lengths)
+	g.usesStrings = true
+	conds := make([]string, len(lengths))
+	for k, n := range lengths {
+		end := fmt.Sprintf("word[len(word)-%d:]", n)
+		conds[k] = fmt.Sprintf("(len(word) < %d || %s)", n, lookup(end))
+	}
+	return strings.Join(conds, " && "), false
+}
+
+// perfectHash returns a table of a power of two size holding each of the
+// words at the index given by the hash of the word seeded with seed, masked
+// by the size of the table minus one.
+func perfectHash(words []string) ([]string, uint32) {
+	size := 1
+	for size < 2*len(words) {
+		size *= 2
+	}
+	for {
+		for seed := uint32(0); seed < 1000; seed++ {
+			table := make([]string, size)
+			ok := true
+			for _, w := range words {
+				k := fnvHash(seed, w) & uint32(size-1)
+				if table[k] != "" {
+					ok = false
+					break
+				}
+				table[k] = w
+			}
+			if ok {
+				return table, seed
+			}
+		}
+		size *= 2
+	}
+}
+
+// fnvHash is the FNV-1a hash of s, seeded with seed, as in the generated
+// code.
+func fnvHash(seed uint32, s string) uint32 {
+	h := 2166136261 ^ seed
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= 16777619
+	}
+	return h
+}
+
+// quoteGo returns s as a Go string literal, keeping its printable non-ASCII
+// characters.
+func quoteGo(s string) string {
+	if utf8.ValidString(s) {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf("%+q", s)
+}
+
+// quoteByte returns c as a Go rune literal of a byte.
+func quoteByte(c byte) string {
+	if c < utf8.RuneSelf && c >= ' ' && c != '\'' && c != '\\' {
+		return fmt.Sprintf("'%c'", c)
+	}
+	return fmt.Sprintf("0x%02x", c)
+}
+
+func containsInt(list []int, n int) bool {
+	for _, m := range list {
+		if m == n {
+			return true
+		}
+	}
+	return false
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
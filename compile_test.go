@@ -0,0 +1,118 @@
+package rslp
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestWriteGo(t *testing.T) {
+	rs, err := LoadRules("test", strings.NewReader(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := rs.WriteGo(&buf, GoOptions{Package: "stemmer", Name: "Test"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"// Code generated by rslp.WriteGo; DO NOT EDIT.\n\npackage stemmer\n",
+		"var Test = testMustLoad(\"test\", testRules).Compiled(testRun)\n",
+		"func testStep0(word string) (string, bool) {\n",
+		"\tcase 's':\n",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Fatalf("missing %q in\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestWriteGoSamePackage(t *testing.T) {
+	rs, err := LoadRules("test", strings.NewReader(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the declarations of stemmers generated into the same package don't
+	// clash.
+	declared := map[string]string{}
+	for _, name := range []string{"Test", "Other"} {
+		var buf bytes.Buffer
+		if err := rs.WriteGo(&buf, GoOptions{Package: "stemmer", Name: name}); err != nil {
+			t.Fatal(err)
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), name, buf.Bytes(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for ident := range f.Scope.Objects {
+			if other, ok := declared[ident]; ok {
+				t.Fatalf("%s redeclared by %s and %s", ident, other, name)
+			}
+			declared[ident] = name
+		}
+	}
+}
+
+func TestWriteGoErrors(t *testing.T) {
+	lucene, err := LoadRulesYAML("test", strings.NewReader("lucene: true\nsteps:\n  - name: A\n    rules:\n      - suffix: s\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		rs   *RuleSet
+		opts GoOptions
+	}{
+		{Portuguese, GoOptions{Package: "", Name: "Portuguese"}},
+		{Portuguese, GoOptions{Package: "compiled", Name: "portuguese"}},
+		{Portuguese, GoOptions{Package: "compiled", Name: "Por-tuguese"}},
+		{lucene, GoOptions{Package: "compiled", Name: "Test"}},
+	} {
+		if err := tt.rs.WriteGo(&bytes.Buffer{}, tt.opts); err == nil {
+			t.Fatalf("expected an error for %+v", tt.opts)
+		}
+	}
+}
+
+func TestPerfectHash(t *testing.T) {
+	words := Portuguese.steps["Plural"].rules[7].exceptions
+	table, seed := perfectHash(words)
+	if len(table)&(len(table)-1) != 0 || len(table) < 2*len(words) {
+		t.Fatalf("invalid table size %d for %d words", len(table), len(words))
+	}
+	for _, w := range words {
+		if table[fnvHash(seed, w)&uint32(len(table)-1)] != w {
+			t.Fatalf("%q is not at its hash", w)
+		}
+	}
+}
+
+func TestCompiledRuleSet(t *testing.T) {
+	calls := 0
+	rs := Portuguese.Compiled(func(word string) string {
+		calls++
+		return Portuguese.run(word, false, nil)
+	})
+	if got := (Options{Rules: rs}).Stem("gatinhas"); got != "gat" || calls != 1 {
+		t.Fatalf("invalid compiled stem %q after %d calls", got, calls)
+	}
+
+	// the statistics trace the steps themselves.
+	NewStats(Options{Rules: rs}).Stem("gatinhas")
+	if calls != 1 {
+		t.Fatal("the compiled steps ran while tracing")
+	}
+
+	// a changed rule set no longer runs the compiled steps.
+	changed, err := rs.Apply(Suggestion{Step: "Plural", Rule: 10, Suffix: "s", Exception: "gatinhas"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := (Options{Rules: changed}).Stem("gatinhas"); got != "gatinhas" || calls != 1 {
+		t.Fatalf("invalid changed stem %q after %d calls", got, calls)
+	}
+}
@@ -0,0 +1,10 @@
+// Package compiled provides the built-in rule sets of rslp with their steps
+// compiled to Go code by rslpgen, which stem the same words as the rule sets
+// they are generated from, faster:
+//
+//	opts := rslp.Options{Rules: compiled.Portuguese}
+//	opts.Stem("gatinhas") // gat
+package compiled
+
+//go:generate go run ../cmd/rslpgen -rules portuguese -name Portuguese -o portuguese.go
+//go:generate go run ../cmd/rslpgen -rules lucene-portuguese -name LucenePortuguese -o lucene.go
@@ -0,0 +1,99 @@
+package compiled
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/knuppe/rslp"
+)
+
+// readWords returns the words of the first column of a tab separated file.
+func readWords(t testing.TB, name string) []string {
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var words []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		text := scanner.Text()
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		words = append(words, strings.Split(text, "\t")[0])
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return words
+}
+
+func TestCompiled(t *testing.T) {
+	golden := readWords(t, "../testdata/golden/vocabulary.tsv")
+	lucene := readWords(t, "../testdata/lucene/snapshot.tsv")
+
+	for _, tt := range []struct {
+		compiled, interpreted *rslp.RuleSet
+		words                 []string
+	}{
+		{Portuguese, rslp.Portuguese, golden},
+		{LucenePortuguese, rslp.LucenePortuguese, append(lucene, golden...)},
+	} {
+		for _, keep := range []bool{false, true} {
+			compiled := rslp.Options{Rules: tt.compiled, KeepDiacritics: keep}
+			interpreted := rslp.Options{Rules: tt.interpreted, KeepDiacritics: keep}
+			for _, word := range tt.words {
+				if got, want := compiled.Stem(word), interpreted.Stem(word); got != want {
+					t.Fatalf("%s: invalid stem output, %q -> %q (got %q)", tt.compiled.Name(), word, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestCompiledUpToDate(t *testing.T) {
+	for _, tt := range []struct {
+		file, rules, name string
+		rs                *rslp.RuleSet
+	}{
+		{"portuguese.go", "portuguese", "Portuguese", rslp.Portuguese},
+		{"lucene.go", "lucene-portuguese", "LucenePortuguese", rslp.LucenePortuguese},
+	} {
+		var b strings.Builder
+		err := tt.rs.WriteGo(&b, rslp.GoOptions{
+			Package: "compiled",
+			Name:    tt.name,
+			Command: "rslpgen -rules " + tt.rules + " -name " + tt.name + " -o " + tt.file,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := os.ReadFile(tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != b.String() {
+			t.Fatalf("%s is out of date, run go generate", tt.file)
+		}
+	}
+}
+
+func BenchmarkCompiled(b *testing.B) {
+	words := readWords(b, "../testdata/golden/vocabulary.tsv")
+	for _, rs := range []*rslp.RuleSet{rslp.Portuguese, Portuguese} {
+		opts := rslp.Options{Rules: rs}
+		name := "interpreted"
+		if rs == Portuguese {
+			name = "compiled"
+		}
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				opts.Stem(words[i%len(words)])
+			}
+		})
+	}
+}
@@ -0,0 +1,1640 @@
+// Code generated by rslpgen -rules lucene-portuguese -name LucenePortuguese -o lucene.go; DO NOT EDIT.
+
+package compiled
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/knuppe/rslp"
+)
+
+// LucenePortuguese is the "lucene-portuguese" rule set with its steps compiled.
+var LucenePortuguese = rslp.LucenePortuguese.Compiled(lucenePortugueseRun)
+
+// lucenePortugueseRun runs the steps of the rule set on a lowercase word.
+func lucenePortugueseRun(word string) string {
+	var ok bool
+	n := 0
+	goto step0
+
+step0: // Plural
+	if n == 100 {
+		return word
+	}
+	n++
+	word, _ = lucenePortugueseStep0(word)
+	goto step2
+
+step1: // Feminine
+	if n == 100 {
+		return word
+	}
+	n++
+	word, _ = lucenePortugueseStep1(word)
+	goto step3
+
+step2: // Adverb
+	if n == 100 {
+		return word
+	}
+	n++
+	word, _ = lucenePortugueseStep2(word)
+	goto step1
+
+step3: // Augmentative
+	if n == 100 {
+		return word
+	}
+	n++
+	word, _ = lucenePortugueseStep3(word)
+	goto step4
+
+step4: // Noun
+	if n == 100 {
+		return word
+	}
+	n++
+	if word, ok = lucenePortugueseStep4(word); ok {
+		return word
+	}
+	goto step5
+
+step5: // Verb
+	if n == 100 {
+		return word
+	}
+	n++
+	if word, ok = lucenePortugueseStep5(word); ok {
+		return word
+	}
+	goto step6
+
+step6: // Vowel
+	if n == 100 {
+		return word
+	}
+	n++
+	word, _ = lucenePortugueseStep6(word)
+	return word
+}
+
+// lucenePortugueseStep0 runs the "Plural" step.
+func lucenePortugueseStep0(word string) (string, bool) {
+	n := utf8.RuneCountInString(word)
+	if n < 3 {
+		return word, false
+	}
+	if !strings.HasSuffix(word, "s") {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 's':
+		if strings.HasSuffix(word, "ns") && n >= 3 {
+			return word[:len(word)-2] + "m", true
+		}
+		if strings.HasSuffix(word, "ões") && n >= 6 {
+			return word[:len(word)-4] + "ão", true
+		}
+		if strings.HasSuffix(word, "ães") && n >= 4 && lucenePortugueseExceptions0_2[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-4] + "ão", true
+		}
+		if strings.HasSuffix(word, "ais") && n >= 4 && lucenePortugueseExceptions0_3[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-3] + "al", true
+		}
+		if strings.HasSuffix(word, "éis") && n >= 5 {
+			return word[:len(word)-4] + "el", true
+		}
+		if strings.HasSuffix(word, "eis") && n >= 5 {
+			return word[:len(word)-3] + "el", true
+		}
+		if strings.HasSuffix(word, "óis") && n >= 5 {
+			return word[:len(word)-4] + "ol", true
+		}
+		if strings.HasSuffix(word, "is") && n >= 4 && lucenePortugueseExceptions0_7[lucenePortugueseHash(1, word)&31] != word {
+			return word[:len(word)-2] + "il", false
+		}
+		if strings.HasSuffix(word, "les") && n >= 6 {
+			return word[:len(word)-3] + "l", true
+		}
+		if strings.HasSuffix(word, "res") && n >= 6 {
+			return word[:len(word)-3] + "r", true
+		}
+		if n >= 3 && lucenePortugueseExceptions0_10[lucenePortugueseHash(0, word)&127] != word {
+			return word[:len(word)-1], true
+		}
+	}
+	return word, false
+}
+
+// lucenePortugueseExceptions0_2 are the exceptions of the -ães rule, by their hash.
+var lucenePortugueseExceptions0_2 = [2]string{
+	1: "mãe",
+}
+
+// lucenePortugueseExceptions0_3 are the exceptions of the -ais rule, by their hash.
+var lucenePortugueseExceptions0_3 = [4]string{
+	1: "cais",
+	3: "mais",
+}
+
+// lucenePortugueseExceptions0_7 are the exceptions of the -is rule, by their hash.
+var lucenePortugueseExceptions0_7 = [32]string{
+	1:  "crúcis",
+	4:  "cais",
+	9:  "pois",
+	18: "mais",
+	19: "biquínis",
+	24: "lápis",
+	26: "depois",
+	27: "leis",
+	29: "dois",
+}
+
+// lucenePortugueseExceptions0_10 are the exceptions of the -s rule, by their hash.
+var lucenePortugueseExceptions0_10 = [128]string{
+	3:   "atrás",
+	5:   "pêsames",
+	10:  "fezes",
+	15:  "ambas",
+	19:  "após",
+	22:  "moisés",
+	24:  "aliás",
+	28:  "messias",
+	31:  "mais",
+	33:  "ês",
+	36:  "convés",
+	45:  "menos",
+	52:  "férias",
+	65:  "lápis",
+	75:  "gás",
+	83:  "país",
+	98:  "crúcis",
+	100: "pires",
+	105: "cais",
+	117: "ambos",
+	120: "mas",
+	122: "através",
+}
+
+// lucenePortugueseStep1 runs the "Feminine" step.
+func lucenePortugueseStep1(word string) (string, bool) {
+	n := utf8.RuneCountInString(word)
+	if n < 3 {
+		return word, false
+	}
+	if !strings.HasSuffix(word, "a") {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 'a':
+		if strings.HasSuffix(word, "ona") && n >= 6 && lucenePortugueseExceptions1_0[lucenePortugueseHash(2, word)&31] != word {
+			return word[:len(word)-3] + "ão", true
+		}
+		if strings.HasSuffix(word, "ora") && n >= 6 {
+			return word[:len(word)-3] + "or", true
+		}
+		if strings.HasSuffix(word, "na") && n >= 6 && lucenePortugueseExceptions1_2[lucenePortugueseHash(12, word)&63] != word {
+			return word[:len(word)-2] + "no", false
+		}
+		if strings.HasSuffix(word, "inha") && n >= 7 && lucenePortugueseExceptions1_3[lucenePortugueseHash(0, word)&7] != word {
+			return word[:len(word)-4] + "inho", false
+		}
+		if strings.HasSuffix(word, "esa") && n >= 6 && lucenePortugueseExceptions1_4[lucenePortugueseHash(1, word)&15] != word {
+			return word[:len(word)-3] + "ês", true
+		}
+		if strings.HasSuffix(word, "osa") && n >= 6 && lucenePortugueseExceptions1_5[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-3] + "oso", false
+		}
+		if strings.HasSuffix(word, "íaca") && n >= 7 {
+			return word[:len(word)-5] + "íaco", false
+		}
+		if strings.HasSuffix(word, "ica") && n >= 6 && lucenePortugueseExceptions1_7[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3] + "ico", false
+		}
+		if strings.HasSuffix(word, "ada") && n >= 5 && lucenePortugueseExceptions1_8[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3] + "ado", false
+		}
+		if strings.HasSuffix(word, "ida") && n >= 6 && lucenePortugueseExceptions1_9[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3] + "ido", false
+		}
+		if strings.HasSuffix(word, "ída") && n >= 6 && lucenePortugueseExceptions1_10[lucenePortugueseHash(0, word)&7] != word {
+			return word[:len(word)-4] + "ido", false
+		}
+		if strings.HasSuffix(word, "ima") && n >= 6 && lucenePortugueseExceptions1_11[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3] + "imo", false
+		}
+		if strings.HasSuffix(word, "iva") && n >= 6 && lucenePortugueseExceptions1_12[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-3] + "ivo", false
+		}
+		if strings.HasSuffix(word, "eira") && n >= 7 && lucenePortugueseExceptions1_13[lucenePortugueseHash(4, word)&31] != word {
+			return word[:len(word)-4] + "eiro", false
+		}
+	case 0xa3:
+		if strings.HasSuffix(word, "ã") && n >= 3 && lucenePortugueseExceptions1_14[lucenePortugueseHash(1, word)&7] != word {
+			return word[:len(word)-2] + "ão", true
+		}
+	}
+	return word, false
+}
+
+// lucenePortugueseExceptions1_0 are the exceptions of the -ona rule, by their hash.
+var lucenePortugueseExceptions1_0 = [32]string{
+	1:  "carona",
+	5:  "monótona",
+	6:  "iona",
+	7:  "lona",
+	8:  "maratona",
+	13: "cortisona",
+	15: "abandona",
+	24: "detona",
+	30: "acetona",
+}
+
+// lucenePortugueseExceptions1_2 are the exceptions of the -na rule, by their hash.
+var lucenePortugueseExceptions1_2 = [64]string{
+	1:  "lona",
+	14: "guiana",
+	16: "acetona",
+	20: "banana",
+	26: "detona",
+	27: "carona",
+	28: "paisana",
+	32: "caravana",
+	34: "campana",
+	35: "cortisona",
+	38: "maratona",
+	41: "abandona",
+	51: "monótona",
+	56: "iona",
+	58: "grana",
+}
+
+// lucenePortugueseExceptions1_3 are the exceptions of the -inha rule, by their hash.
+var lucenePortugueseExceptions1_3 = [8]string{
+	0: "rainha",
+	3: "linha",
+	4: "minha",
+}
+
+// lucenePortugueseExceptions1_4 are the exceptions of the -esa rule, by their hash.
+var lucenePortugueseExceptions1_4 = [16]string{
+	1:  "presa",
+	4:  "ilesa",
+	8:  "turquesa",
+	9:  "princesa",
+	11: "pesa",
+	12: "obesa",
+	14: "mesa",
+}
+
+// lucenePortugueseExceptions1_5 are the exceptions of the -osa rule, by their hash.
+var lucenePortugueseExceptions1_5 = [4]string{
+	0: "prosa",
+	1: "mucosa",
+}
+
+// lucenePortugueseExceptions1_7 are the exceptions of the -ica rule, by their hash.
+var lucenePortugueseExceptions1_7 = [2]string{
+	0: "dica",
+}
+
+// lucenePortugueseExceptions1_8 are the exceptions of the -ada rule, by their hash.
+var lucenePortugueseExceptions1_8 = [2]string{
+	0: "pitada",
+}
+
+// lucenePortugueseExceptions1_9 are the exceptions of the -ida rule, by their hash.
+var lucenePortugueseExceptions1_9 = [2]string{
+	1: "vida",
+}
+
+// lucenePortugueseExceptions1_10 are the exceptions of the -ída rule, by their hash.
+var lucenePortugueseExceptions1_10 = [8]string{
+	2: "saída",
+	3: "recaída",
+	4: "dúvida",
+}
+
+// lucenePortugueseExceptions1_11 are the exceptions of the -ima rule, by their hash.
+var lucenePortugueseExceptions1_11 = [2]string{
+	0: "vítima",
+}
+
+// lucenePortugueseExceptions1_12 are the exceptions of the -iva rule, by their hash.
+var lucenePortugueseExceptions1_12 = [4]string{
+	0: "oliva",
+	3: "saliva",
+}
+
+// lucenePortugueseExceptions1_13 are the exceptions of the -eira rule, by their hash.
+var lucenePortugueseExceptions1_13 = [32]string{
+	3:  "capoeira",
+	7:  "frigideira",
+	8:  "cadeira",
+	10: "beira",
+	14: "feira",
+	15: "poeira",
+	23: "fronteira",
+	24: "besteira",
+	25: "bandeira",
+	31: "barreira",
+}
+
+// lucenePortugueseExceptions1_14 are the exceptions of the -ã rule, by their hash.
+var lucenePortugueseExceptions1_14 = [8]string{
+	1: "amanhã",
+	4: "fã",
+	5: "divã",
+	7: "arapuã",
+}
+
+// lucenePortugueseStep2 runs the "Adverb" step.
+func lucenePortugueseStep2(word string) (string, bool) {
+	n := utf8.RuneCountInString(word)
+	if word == "" {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 'e':
+		if strings.HasSuffix(word, "mente") && n >= 9 && lucenePortugueseExceptions2_0[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-5], true
+		}
+	}
+	return word, false
+}
+
+// lucenePortugueseExceptions2_0 are the exceptions of the -mente rule, by their hash.
+var lucenePortugueseExceptions2_0 = [2]string{
+	1: "experimente",
+}
+
+// lucenePortugueseStep3 runs the "Augmentative" step.
+func lucenePortugueseStep3(word string) (string, bool) {
+	n := utf8.RuneCountInString(word)
+	if word == "" {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 'a':
+		if strings.HasSuffix(word, "uça") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "aça") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "arra") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+	case 'o':
+		if strings.HasSuffix(word, "díssimo") && n >= 12 {
+			return word[:len(word)-8], true
+		}
+		if strings.HasSuffix(word, "abilíssimo") && n >= 15 {
+			return word[:len(word)-11], true
+		}
+		if strings.HasSuffix(word, "íssimo") && n >= 9 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "ésimo") && n >= 8 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "érrimo") && n >= 10 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "zinho") && n >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "quinho") && n >= 10 {
+			return word[:len(word)-6] + "c", true
+		}
+		if strings.HasSuffix(word, "uinho") && n >= 9 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "adinho") && n >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "inho") && n >= 7 && lucenePortugueseExceptions3_9[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "alhão") && n >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "aço") && n >= 7 && lucenePortugueseExceptions3_12[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "adão") && n >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "idão") && n >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ázio") && n >= 7 && lucenePortugueseExceptions3_16[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "zarrão") && n >= 9 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "arrão") && n >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "zão") && n >= 5 && lucenePortugueseExceptions3_21[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ão") && n >= 5 && lucenePortugueseExceptions3_22[lucenePortugueseHash(1, word)&255] != word {
+			return word[:len(word)-3], true
+		}
+	case 'z':
+		if strings.HasSuffix(word, "arraz") && n >= 9 {
+			return word[:len(word)-5], true
+		}
+	}
+	return word, false
+}
+
+// lucenePortugueseExceptions3_9 are the exceptions of the -inho rule, by their hash.
+var lucenePortugueseExceptions3_9 = [4]string{
+	0: "cominho",
+	2: "caminho",
+}
+
+// lucenePortugueseExceptions3_12 are the exceptions of the -aço rule, by their hash.
+var lucenePortugueseExceptions3_12 = [2]string{
+	1: "antebraço",
+}
+
+// lucenePortugueseExceptions3_16 are the exceptions of the -ázio rule, by their hash.
+var lucenePortugueseExceptions3_16 = [2]string{
+	0: "topázio",
+}
+
+// lucenePortugueseExceptions3_21 are the exceptions of the -zão rule, by their hash.
+var lucenePortugueseExceptions3_21 = [2]string{
+	0: "coalizão",
+}
+
+// lucenePortugueseExceptions3_22 are the exceptions of the -ão rule, by their hash.
+var lucenePortugueseExceptions3_22 = [256]string{
+	4:   "colchão",
+	14:  "gamão",
+	24:  "coração",
+	28:  "ilusão",
+	34:  "macacão",
+	48:  "patrão",
+	53:  "rincão",
+	54:  "leão",
+	62:  "tração",
+	66:  "cristão",
+	69:  "senão",
+	72:  "furacão",
+	90:  "espião",
+	93:  "leilão",
+	102: "nação",
+	108: "lampião",
+	109: "milhão",
+	119: "ficção",
+	120: "capitão",
+	129: "aptidão",
+	135: "fogão",
+	139: "falcão",
+	147: "cordão",
+	149: "feição",
+	160: "estação",
+	164: "embrião",
+	165: "orgão",
+	173: "órfão",
+	181: "limão",
+	183: "campeão",
+	186: "quinhão",
+	187: "chimarrão",
+	201: "glutão",
+	207: "camarão",
+	213: "grotão",
+	216: "mamão",
+	222: "portão",
+	231: "folião",
+	233: "fusão",
+	236: "bilhão",
+	240: "barão",
+	251: "canção",
+	253: "melão",
+}
+
+// lucenePortugueseStep4 runs the "Noun" step.
+func lucenePortugueseStep4(word string) (string, bool) {
+	n := utf8.RuneCountInString(word)
+	if word == "" {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 'a':
+		if strings.HasSuffix(word, "encialista") && n >= 14 {
+			return word[:len(word)-10], true
+		}
+		if strings.HasSuffix(word, "alista") && n >= 11 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "atória") && n >= 11 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "icionista") && n >= 13 {
+			return word[:len(word)-9], true
+		}
+		if strings.HasSuffix(word, "cionista") && n >= 13 {
+			return word[:len(word)-8], true
+		}
+		if strings.HasSuffix(word, "ionista") && n >= 12 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "ência") && n >= 8 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "ância") && n >= 9 && lucenePortugueseExceptions4_32[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "eza") && n >= 6 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "oria") && n >= 8 && lucenePortugueseExceptions4_62[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ista") && n >= 8 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "auta") && n >= 9 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ura") && n >= 7 && lucenePortugueseExceptions4_77[lucenePortugueseHash(0, word)&7] != word {
+			return word[:len(word)-3], true
+		}
+	case 'e':
+		if strings.HasSuffix(word, "abilidade") && n >= 14 {
+			return word[:len(word)-9], true
+		}
+		if strings.HasSuffix(word, "ante") && n >= 6 && lucenePortugueseExceptions4_53[lucenePortugueseHash(1, word)&15] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ividade") && n >= 12 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "idade") && n >= 9 && lucenePortugueseExceptions4_61[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "quice") && n >= 9 {
+			return word[:len(word)-5] + "c", true
+		}
+		if strings.HasSuffix(word, "ice") && n >= 7 && lucenePortugueseExceptions4_67[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ente") && n >= 8 && lucenePortugueseExceptions4_69[lucenePortugueseHash(1, word)&15] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ense") && n >= 9 {
+			return word[:len(word)-4], true
+		}
+	case 'l':
+		if strings.HasSuffix(word, "ional") && n >= 9 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "encial") && n >= 11 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "inal") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ável") && n >= 6 && lucenePortugueseExceptions4_73[lucenePortugueseHash(0, word)&7] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ível") && n >= 7 && lucenePortugueseExceptions4_74[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "vel") && n >= 8 && lucenePortugueseExceptions4_75[lucenePortugueseHash(0, word)&7] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "bil") && n >= 6 {
+			return word[:len(word)-3] + "vel", false
+		}
+		if strings.HasSuffix(word, "ural") && n >= 8 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ual") && n >= 6 && lucenePortugueseExceptions4_79[lucenePortugueseHash(0, word)&7] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ial") && n >= 6 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "al") && n >= 6 && lucenePortugueseExceptions4_81[lucenePortugueseHash(3, word)&63] != word {
+			return word[:len(word)-2], true
+		}
+	case 'm':
+		if strings.HasSuffix(word, "agem") && n >= 7 && lucenePortugueseExceptions4_2[lucenePortugueseHash(2, word)&7] != word {
+			return word[:len(word)-4], true
+		}
+	case 'o':
+		if strings.HasSuffix(word, "ático") && n >= 8 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "iamento") && n >= 11 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "amento") && n >= 9 && lucenePortugueseExceptions4_5[lucenePortugueseHash(0, word)&7] != word {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "imento") && n >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "mento") && n >= 11 && lucenePortugueseExceptions4_7[lucenePortugueseHash(0, word)&15] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "alizado") && n >= 11 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "atizado") && n >= 11 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "tizado") && n >= 10 && lucenePortugueseExceptions4_10[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "izado") && n >= 10 && lucenePortugueseExceptions4_11[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ativo") && n >= 9 && lucenePortugueseExceptions4_12[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "tivo") && n >= 8 && lucenePortugueseExceptions4_13[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ivo") && n >= 7 && lucenePortugueseExceptions4_14[lucenePortugueseHash(1, word)&7] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ado") && n >= 5 && lucenePortugueseExceptions4_15[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ido") && n >= 6 && lucenePortugueseExceptions4_16[lucenePortugueseHash(1, word)&31] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "edouro") && n >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "queiro") && n >= 9 {
+			return word[:len(word)-6] + "c", true
+		}
+		if strings.HasSuffix(word, "adeiro") && n >= 10 && lucenePortugueseExceptions4_35[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "eiro") && n >= 7 && lucenePortugueseExceptions4_36[lucenePortugueseHash(0, word)&7] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "uoso") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "oso") && n >= 6 && lucenePortugueseExceptions4_38[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ário") && n >= 7 && lucenePortugueseExceptions4_45[lucenePortugueseHash(5, word)&15] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "atório") && n >= 9 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "ário") && n >= 9 && lucenePortugueseExceptions4_47[lucenePortugueseHash(0, word)&31] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ério") && n >= 10 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "esco") && n >= 8 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ástico") && n >= 10 && lucenePortugueseExceptions4_54[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "alístico") && n >= 11 {
+			return word[:len(word)-9], true
+		}
+		if strings.HasSuffix(word, "áutico") && n >= 10 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "êutico") && n >= 10 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "tico") && n >= 7 && lucenePortugueseExceptions4_58[lucenePortugueseHash(3, word)&63] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ico") && n >= 7 && lucenePortugueseExceptions4_59[lucenePortugueseHash(1, word)&7] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "íaco") && n >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ano") && n >= 7 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "alismo") && n >= 10 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "ivismo") && n >= 10 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "ismo") && n >= 7 && lucenePortugueseExceptions4_84[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+	case 'r':
+		if strings.HasSuffix(word, "ador") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "edor") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "idor") && n >= 8 && lucenePortugueseExceptions4_19[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "dor") && n >= 7 && lucenePortugueseExceptions4_20[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "sor") && n >= 7 && lucenePortugueseExceptions4_21[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "tor") && n >= 6 && lucenePortugueseExceptions4_23[lucenePortugueseHash(0, word)&15] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "or") && n >= 4 && lucenePortugueseExceptions4_24[lucenePortugueseHash(6, word)&63] != word {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "ionar") && n >= 10 {
+			return word[:len(word)-5], true
+		}
+	case 's':
+		if strings.HasSuffix(word, "ês") && n >= 6 {
+			return word[:len(word)-3], true
+		}
+	case 'z':
+		if strings.HasSuffix(word, "ez") && n >= 6 {
+			return word[:len(word)-2], true
+		}
+	case 0xa7:
+		if strings.HasSuffix(word, "alizaç") && n >= 11 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "atizaç") && n >= 11 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "tizaç") && n >= 10 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "izaç") && n >= 9 && lucenePortugueseExceptions4_42[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "aç") && n >= 5 && lucenePortugueseExceptions4_43[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "iç") && n >= 5 && lucenePortugueseExceptions4_44[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+	}
+	return word, false
+}
+
+// lucenePortugueseExceptions4_32 are the exceptions of the -ância rule, by their hash.
+var lucenePortugueseExceptions4_32 = [2]string{
+	0: "ambulância",
+}
+
+// lucenePortugueseExceptions4_62 are the exceptions of the -oria rule, by their hash.
+var lucenePortugueseExceptions4_62 = [2]string{
+	0: "categoria",
+}
+
+// lucenePortugueseExceptions4_77 are the exceptions of the -ura rule, by their hash.
+var lucenePortugueseExceptions4_77 = [8]string{
+	0: "imatura",
+	2: "costura",
+	5: "acupuntura",
+}
+
+// lucenePortugueseExceptions4_53 are the exceptions of the -ante rule, by their hash.
+var lucenePortugueseExceptions4_53 = [16]string{
+	0:  "instante",
+	2:  "restaurante",
+	9:  "gigante",
+	10: "elefante",
+	12: "adiante",
+	15: "possante",
+}
+
+// lucenePortugueseExceptions4_61 are the exceptions of the -idade rule, by their hash.
+var lucenePortugueseExceptions4_61 = [4]string{
+	0: "comunidade",
+	3: "autoridade",
+}
+
+// lucenePortugueseExceptions4_67 are the exceptions of the -ice rule, by their hash.
+var lucenePortugueseExceptions4_67 = [2]string{
+	1: "cúmplice",
+}
+
+// lucenePortugueseExceptions4_69 are the exceptions of the -ente rule, by their hash.
+var lucenePortugueseExceptions4_69 = [16]string{
+	0:  "oriente",
+	3:  "alimente",
+	9:  "freqüente",
+	13: "permanente",
+	14: "aparente",
+	15: "acrescente",
+}
+
+// lucenePortugueseExceptions4_73 are the exceptions of the -ável rule, by their hash.
+var lucenePortugueseExceptions4_73 = [8]string{
+	2: "razoável",
+	4: "vulnerável",
+	5: "afável",
+	7: "potável",
+}
+
+// lucenePortugueseExceptions4_74 are the exceptions of the -ível rule, by their hash.
+var lucenePortugueseExceptions4_74 = [2]string{
+	1: "possível",
+}
+
+// lucenePortugueseExceptions4_75 are the exceptions of the -vel rule, by their hash.
+var lucenePortugueseExceptions4_75 = [8]string{
+	1: "possível",
+	4: "vulnerável",
+	7: "solúvel",
+}
+
+// lucenePortugueseExceptions4_79 are the exceptions of the -ual rule, by their hash.
+var lucenePortugueseExceptions4_79 = [8]string{
+	0: "pontual",
+	1: "visual",
+	3: "bissexual",
+	4: "virtual",
+}
+
+// lucenePortugueseExceptions4_81 are the exceptions of the -al rule, by their hash.
+var lucenePortugueseExceptions4_81 = [64]string{
+	2:  "animal",
+	5:  "virtual",
+	6:  "estatal",
+	8:  "sucursal",
+	10: "bissexual",
+	16: "sideral",
+	20: "fiscal",
+	21: "pontual",
+	23: "afinal",
+	28: "desleal",
+	37: "postal",
+	41: "pessoal",
+	42: "visual",
+	43: "liberal",
+	61: "formal",
+}
+
+// lucenePortugueseExceptions4_2 are the exceptions of the -agem rule, by their hash.
+var lucenePortugueseExceptions4_2 = [8]string{
+	3: "chantagem",
+	4: "carruagem",
+	6: "vantagem",
+	7: "coragem",
+}
+
+// lucenePortugueseExceptions4_5 are the exceptions of the -amento rule, by their hash.
+var lucenePortugueseExceptions4_5 = [8]string{
+	1: "firmamento",
+	3: "departamento",
+	6: "fundamento",
+}
+
+// lucenePortugueseExceptions4_7 are the exceptions of the -mento rule, by their hash.
+var lucenePortugueseExceptions4_7 = [16]string{
+	1:  "firmamento",
+	6:  "complemento",
+	8:  "elemento",
+	11: "departamento",
+	13: "instrumento",
+}
+
+// lucenePortugueseExceptions4_10 are the exceptions of the -tizado rule, by their hash.
+var lucenePortugueseExceptions4_10 = [2]string{
+	1: "alfabetizado",
+}
+
+// lucenePortugueseExceptions4_11 are the exceptions of the -izado rule, by their hash.
+var lucenePortugueseExceptions4_11 = [4]string{
+	1: "organizado",
+	2: "pulverizado",
+}
+
+// lucenePortugueseExceptions4_12 are the exceptions of the -ativo rule, by their hash.
+var lucenePortugueseExceptions4_12 = [4]string{
+	0: "pejorativo",
+	3: "relativo",
+}
+
+// lucenePortugueseExceptions4_13 are the exceptions of the -tivo rule, by their hash.
+var lucenePortugueseExceptions4_13 = [2]string{
+	1: "relativo",
+}
+
+// lucenePortugueseExceptions4_14 are the exceptions of the -ivo rule, by their hash.
+var lucenePortugueseExceptions4_14 = [8]string{
+	1: "pejorativo",
+	3: "passivo",
+	4: "possessivo",
+	5: "positivo",
+}
+
+// lucenePortugueseExceptions4_15 are the exceptions of the -ado rule, by their hash.
+var lucenePortugueseExceptions4_15 = [2]string{
+	0: "grado",
+}
+
+// lucenePortugueseExceptions4_16 are the exceptions of the -ido rule, by their hash.
+var lucenePortugueseExceptions4_16 = [32]string{
+	2:  "decido",
+	3:  "tímido",
+	8:  "cândido",
+	16: "rápido",
+	18: "marido",
+	24: "consolido",
+	27: "duvido",
+}
+
+// lucenePortugueseExceptions4_35 are the exceptions of the -adeiro rule, by their hash.
+var lucenePortugueseExceptions4_35 = [2]string{
+	0: "desfiladeiro",
+}
+
+// lucenePortugueseExceptions4_36 are the exceptions of the -eiro rule, by their hash.
+var lucenePortugueseExceptions4_36 = [8]string{
+	2: "pioneiro",
+	6: "desfiladeiro",
+	7: "mosteiro",
+}
+
+// lucenePortugueseExceptions4_38 are the exceptions of the -oso rule, by their hash.
+var lucenePortugueseExceptions4_38 = [2]string{
+	1: "precioso",
+}
+
+// lucenePortugueseExceptions4_45 are the exceptions of the -ário rule, by their hash.
+var lucenePortugueseExceptions4_45 = [16]string{
+	0:  "lionário",
+	4:  "voluntário",
+	8:  "armário",
+	10: "salário",
+	14: "aniversário",
+	15: "diário",
+}
+
+// lucenePortugueseExceptions4_47 are the exceptions of the -ário rule, by their hash.
+var lucenePortugueseExceptions4_47 = [32]string{
+	5:  "armário",
+	7:  "próprio",
+	8:  "compulsório",
+	15: "salário",
+	23: "voluntário",
+	24: "stério",
+	27: "aniversário",
+	28: "diário",
+	31: "lionário",
+}
+
+// lucenePortugueseExceptions4_54 are the exceptions of the -ástico rule, by their hash.
+var lucenePortugueseExceptions4_54 = [2]string{
+	0: "eclesiástico",
+}
+
+// lucenePortugueseExceptions4_58 are the exceptions of the -tico rule, by their hash.
+var lucenePortugueseExceptions4_58 = [64]string{
+	9:  "prático",
+	14: "crítico",
+	17: "diagnóstico",
+	18: "doméstico",
+	20: "político",
+	29: "alopático",
+	36: "autêntico",
+	41: "idêntico",
+	50: "diagnostico",
+	51: "critico",
+	55: "eclesiástico",
+	59: "eclético",
+	61: "artístico",
+}
+
+// lucenePortugueseExceptions4_59 are the exceptions of the -ico rule, by their hash.
+var lucenePortugueseExceptions4_59 = [8]string{
+	0: "público",
+	5: "tico",
+	6: "explico",
+}
+
+// lucenePortugueseExceptions4_84 are the exceptions of the -ismo rule, by their hash.
+var lucenePortugueseExceptions4_84 = [2]string{
+	1: "cinismo",
+}
+
+// lucenePortugueseExceptions4_19 are the exceptions of the -idor rule, by their hash.
+var lucenePortugueseExceptions4_19 = [2]string{
+	1: "ouvidor",
+}
+
+// lucenePortugueseExceptions4_20 are the exceptions of the -dor rule, by their hash.
+var lucenePortugueseExceptions4_20 = [2]string{
+	1: "ouvidor",
+}
+
+// lucenePortugueseExceptions4_21 are the exceptions of the -sor rule, by their hash.
+var lucenePortugueseExceptions4_21 = [2]string{
+	0: "assessor",
+}
+
+// lucenePortugueseExceptions4_23 are the exceptions of the -tor rule, by their hash.
+var lucenePortugueseExceptions4_23 = [16]string{
+	1:  "promotor",
+	3:  "benfeitor",
+	4:  "produtor",
+	6:  "consultor",
+	8:  "editor",
+	12: "pastor",
+	14: "leitor",
+}
+
+// lucenePortugueseExceptions4_24 are the exceptions of the -or rule, by their hash.
+var lucenePortugueseExceptions4_24 = [64]string{
+	14: "tumor",
+	24: "motor",
+	25: "favor",
+	31: "redor",
+	38: "pastor",
+	42: "rigor",
+	44: "terior",
+	48: "assessor",
+	49: "benfeitor",
+	50: "tambor",
+	56: "melhor",
+	61: "sensor",
+	62: "autor",
+}
+
+// lucenePortugueseExceptions4_42 are the exceptions of the -izaç rule, by their hash.
+var lucenePortugueseExceptions4_42 = [2]string{
+	0: "organizaç",
+}
+
+// lucenePortugueseExceptions4_43 are the exceptions of the -aç rule, by their hash.
+var lucenePortugueseExceptions4_43 = [4]string{
+	1: "relaç",
+	3: "equaç",
+}
+
+// lucenePortugueseExceptions4_44 are the exceptions of the -iç rule, by their hash.
+var lucenePortugueseExceptions4_44 = [2]string{
+	1: "eleição",
+}
+
+// lucenePortugueseStep5 runs the "Verb" step.
+func lucenePortugueseStep5(word string) (string, bool) {
+	n := utf8.RuneCountInString(word)
+	if word == "" {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 'a':
+		if strings.HasSuffix(word, "aria") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "eria") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "iria") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "iava") && n >= 8 && lucenePortugueseExceptions5_62[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "iona") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ara") && n >= 5 && lucenePortugueseExceptions5_65[lucenePortugueseHash(0, word)&15] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ava") && n >= 5 && lucenePortugueseExceptions5_68[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "era") && n >= 6 && lucenePortugueseExceptions5_70[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ira") && n >= 6 && lucenePortugueseExceptions5_76[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "uía") && n >= 8 {
+			return word[:len(word)-4] + "u", true
+		}
+		if strings.HasSuffix(word, "ia") && n >= 5 && lucenePortugueseExceptions5_95[lucenePortugueseHash(12, word)&31] != word {
+			return word[:len(word)-2], true
+		}
+	case 'e':
+		if strings.HasSuffix(word, "arde") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "asse") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "aste") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "erde") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "esse") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "este") && n >= 7 && lucenePortugueseExceptions5_50[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "irde") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "isse") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "iste") && n >= 8 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "are") && n >= 5 && lucenePortugueseExceptions5_67[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ere") && n >= 6 && lucenePortugueseExceptions5_72[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ire") && n >= 6 && lucenePortugueseExceptions5_82[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+	case 'i':
+		if strings.HasSuffix(word, "árei") && n >= 6 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "aríei") && n >= 7 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "ássei") && n >= 7 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "eríei") && n >= 8 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "êssei") && n >= 8 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "iríei") && n >= 8 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "íssei") && n >= 8 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "arei") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ávei") && n >= 6 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "erei") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "êrei") && n >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "irei") && n >= 7 && lucenePortugueseExceptions5_56[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "íei") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ai") && n >= 4 {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "uei") && n >= 6 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ei") && n >= 5 {
+			return word[:len(word)-2], true
+		}
+		if n >= 4 {
+			return word[:len(word)-1], true
+		}
+	case 'm':
+		if strings.HasSuffix(word, "ariam") && n >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "assem") && n >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "eriam") && n >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "essem") && n >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "iriam") && n >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "issem") && n >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "aram") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "arem") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "avam") && n >= 6 && lucenePortugueseExceptions5_39[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "eram") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "erem") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "iram") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "íram") && n >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "irem") && n >= 7 && lucenePortugueseExceptions5_57[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "iam") && n >= 6 && lucenePortugueseExceptions5_73[lucenePortugueseHash(0, word)&7] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "am") && n >= 4 {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "guem") && n >= 7 {
+			return word[:len(word)-4] + "g", true
+		}
+		if strings.HasSuffix(word, "em") && n >= 4 && lucenePortugueseExceptions5_92[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-2], true
+		}
+	case 'o':
+		if strings.HasSuffix(word, "aríamo") && n >= 8 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "ássemo") && n >= 8 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "eríamo") && n >= 8 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "êssemo") && n >= 8 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "iríamo") && n >= 9 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "íssemo") && n >= 9 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "áramo") && n >= 7 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "aremo") && n >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ávamo") && n >= 7 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "êramo") && n >= 8 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "eremo") && n >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "íramo") && n >= 8 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "iremo") && n >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ando") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "endo") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "indo") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ondo") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "arão") && n >= 6 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "armo") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "erão") && n >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ermo") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "íamo") && n >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "irão") && n >= 6 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "irmo") && n >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "amo") && n >= 5 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "emo") && n >= 5 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "imo") && n >= 6 && lucenePortugueseExceptions5_75[lucenePortugueseHash(1, word)&15] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ído") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "omo") && n >= 6 {
+			return word[:len(word)-3], true
+		}
+	case 'r':
+		if strings.HasSuffix(word, "tizar") && n >= 9 && lucenePortugueseExceptions5_79[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "izar") && n >= 9 && lucenePortugueseExceptions5_80[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "itar") && n >= 9 && lucenePortugueseExceptions5_81[lucenePortugueseHash(2, word)&15] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ear") && n >= 7 && lucenePortugueseExceptions5_86[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ar") && n >= 4 && lucenePortugueseExceptions5_87[lucenePortugueseHash(0, word)&7] != word {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "er") && n >= 4 && lucenePortugueseExceptions5_93[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "ir") && n >= 5 && lucenePortugueseExceptions5_96[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-2], true
+		}
+	case 'u':
+		if strings.HasSuffix(word, "eu") && n >= 5 && lucenePortugueseExceptions5_94[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "iu") && n >= 5 {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "eou") && n >= 8 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ou") && n >= 5 {
+			return word[:len(word)-2], true
+		}
+	case 0xa1:
+		if strings.HasSuffix(word, "ará") && n >= 5 && lucenePortugueseExceptions5_66[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "erá") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "irá") && n >= 6 {
+			return word[:len(word)-4], true
+		}
+	}
+	return word, false
+}
+
+// lucenePortugueseExceptions5_62 are the exceptions of the -iava rule, by their hash.
+var lucenePortugueseExceptions5_62 = [2]string{
+	0: "ampliava",
+}
+
+// lucenePortugueseExceptions5_65 are the exceptions of the -ara rule, by their hash.
+var lucenePortugueseExceptions5_65 = [16]string{
+	0: "prepara",
+	8: "arara",
+}
+
+// lucenePortugueseExceptions5_68 are the exceptions of the -ava rule, by their hash.
+var lucenePortugueseExceptions5_68 = [2]string{
+	1: "agrava",
+}
+
+// lucenePortugueseExceptions5_70 are the exceptions of the -era rule, by their hash.
+var lucenePortugueseExceptions5_70 = [4]string{
+	2: "acelera",
+	3: "espera",
+}
+
+// lucenePortugueseExceptions5_76 are the exceptions of the -ira rule, by their hash.
+var lucenePortugueseExceptions5_76 = [4]string{
+	0: "sátira",
+	3: "fronteira",
+}
+
+// lucenePortugueseExceptions5_95 are the exceptions of the -ia rule, by their hash.
+var lucenePortugueseExceptions5_95 = [32]string{
+	1:  "lábia",
+	4:  "elogia",
+	7:  "polícia",
+	11: "estória",
+	15: "arredia",
+	20: "aprecia",
+	21: "mania",
+	24: "ásia",
+	27: "acia",
+	28: "praia",
+	30: "fatia",
+	31: "cheia",
+}
+
+// lucenePortugueseExceptions5_50 are the exceptions of the -este rule, by their hash.
+var lucenePortugueseExceptions5_50 = [4]string{
+	0: "faroeste",
+	2: "agreste",
+}
+
+// lucenePortugueseExceptions5_67 are the exceptions of the -are rule, by their hash.
+var lucenePortugueseExceptions5_67 = [2]string{
+	0: "prepare",
+}
+
+// lucenePortugueseExceptions5_72 are the exceptions of the -ere rule, by their hash.
+var lucenePortugueseExceptions5_72 = [2]string{
+	1: "espere",
+}
+
+// lucenePortugueseExceptions5_82 are the exceptions of the -ire rule, by their hash.
+var lucenePortugueseExceptions5_82 = [2]string{
+	0: "adquire",
+}
+
+// lucenePortugueseExceptions5_56 are the exceptions of the -irei rule, by their hash.
+var lucenePortugueseExceptions5_56 = [2]string{
+	0: "admirei",
+}
+
+// lucenePortugueseExceptions5_39 are the exceptions of the -avam rule, by their hash.
+var lucenePortugueseExceptions5_39 = [2]string{
+	0: "agravam",
+}
+
+// lucenePortugueseExceptions5_57 are the exceptions of the -irem rule, by their hash.
+var lucenePortugueseExceptions5_57 = [2]string{
+	1: "adquirem",
+}
+
+// lucenePortugueseExceptions5_73 are the exceptions of the -iam rule, by their hash.
+var lucenePortugueseExceptions5_73 = [8]string{
+	3: "ensaiam",
+	4: "ampliam",
+	5: "enfiam",
+	7: "elogiam",
+}
+
+// lucenePortugueseExceptions5_92 are the exceptions of the -em rule, by their hash.
+var lucenePortugueseExceptions5_92 = [4]string{
+	1: "virgem",
+	2: "alem",
+}
+
+// lucenePortugueseExceptions5_75 are the exceptions of the -imo rule, by their hash.
+var lucenePortugueseExceptions5_75 = [16]string{
+	0:  "queimo",
+	1:  "íntimo",
+	3:  "ximo",
+	4:  "intimo",
+	5:  "nimo",
+	14: "reprimo",
+}
+
+// lucenePortugueseExceptions5_79 are the exceptions of the -tizar rule, by their hash.
+var lucenePortugueseExceptions5_79 = [2]string{
+	0: "alfabetizar",
+}
+
+// lucenePortugueseExceptions5_80 are the exceptions of the -izar rule, by their hash.
+var lucenePortugueseExceptions5_80 = [2]string{
+	0: "organizar",
+}
+
+// lucenePortugueseExceptions5_81 are the exceptions of the -itar rule, by their hash.
+var lucenePortugueseExceptions5_81 = [16]string{
+	0:  "explicitar",
+	8:  "acreditar",
+	14: "estreitar",
+}
+
+// lucenePortugueseExceptions5_86 are the exceptions of the -ear rule, by their hash.
+var lucenePortugueseExceptions5_86 = [4]string{
+	1: "nuclear",
+	3: "alardear",
+}
+
+// lucenePortugueseExceptions5_87 are the exceptions of the -ar rule, by their hash.
+var lucenePortugueseExceptions5_87 = [8]string{
+	1: "azar",
+	6: "bazaar",
+	7: "patamar",
+}
+
+// lucenePortugueseExceptions5_93 are the exceptions of the -er rule, by their hash.
+var lucenePortugueseExceptions5_93 = [4]string{
+	0: "éter",
+	1: "pier",
+}
+
+// lucenePortugueseExceptions5_96 are the exceptions of the -ir rule, by their hash.
+var lucenePortugueseExceptions5_96 = [2]string{
+	1: "freir",
+}
+
+// lucenePortugueseExceptions5_94 are the exceptions of the -eu rule, by their hash.
+var lucenePortugueseExceptions5_94 = [2]string{
+	1: "chapeu",
+}
+
+// lucenePortugueseExceptions5_66 are the exceptions of the -ará rule, by their hash.
+var lucenePortugueseExceptions5_66 = [2]string{
+	1: "alvará",
+}
+
+// lucenePortugueseStep6 runs the "Vowel" step.
+func lucenePortugueseStep6(word string) (string, bool) {
+	n := utf8.RuneCountInString(word)
+	if word == "" {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 'a':
+		if n >= 4 && lucenePortugueseExceptions6_4[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-1], true
+		}
+	case 'e':
+		if strings.HasSuffix(word, "gue") && n >= 5 && lucenePortugueseExceptions6_1[lucenePortugueseHash(0, word)&3] != word {
+			return word[:len(word)-3] + "g", true
+		}
+		if n >= 4 {
+			return word[:len(word)-1], true
+		}
+	case 'l':
+		if strings.HasSuffix(word, "bil") && n >= 5 {
+			return word[:len(word)-3] + "vel", false
+		}
+	case 'o':
+		if n >= 4 && lucenePortugueseExceptions6_6[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-1], true
+		}
+	case 0xa1:
+		if strings.HasSuffix(word, "á") && n >= 4 {
+			return word[:len(word)-2], true
+		}
+	case 0xaa:
+		if strings.HasSuffix(word, "ê") && n >= 4 && lucenePortugueseExceptions6_3[lucenePortugueseHash(0, word)&1] != word {
+			return word[:len(word)-2], true
+		}
+	}
+	return word, false
+}
+
+// lucenePortugueseExceptions6_4 are the exceptions of the -a rule, by their hash.
+var lucenePortugueseExceptions6_4 = [2]string{
+	0: "ásia",
+}
+
+// lucenePortugueseExceptions6_1 are the exceptions of the -gue rule, by their hash.
+var lucenePortugueseExceptions6_1 = [4]string{
+	0: "gangue",
+	3: "jegue",
+}
+
+// lucenePortugueseExceptions6_6 are the exceptions of the -o rule, by their hash.
+var lucenePortugueseExceptions6_6 = [2]string{
+	0: "ão",
+}
+
+// lucenePortugueseExceptions6_3 are the exceptions of the -ê rule, by their hash.
+var lucenePortugueseExceptions6_3 = [2]string{
+	1: "bebê",
+}
+
+// lucenePortugueseHash is the FNV-1a hash of s, seeded with seed.
+func lucenePortugueseHash(seed uint32, s string) uint32 {
+	h := 2166136261 ^ seed
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= 16777619
+	}
+	return h
+}
@@ -0,0 +1,1632 @@
+// Code generated by rslpgen -rules portuguese -name Portuguese -o portuguese.go; DO NOT EDIT.
+
+package compiled
+
+import (
+	"strings"
+
+	"github.com/knuppe/rslp"
+)
+
+// Portuguese is the "portuguese" rule set with its steps compiled.
+var Portuguese = rslp.Portuguese.Compiled(portugueseRun)
+
+// portugueseRun runs the steps of the rule set on a lowercase word.
+func portugueseRun(word string) string {
+	var ok bool
+	n := 0
+	goto step0
+
+step0: // Plural
+	if n == 100 {
+		return word
+	}
+	n++
+	word, _ = portugueseStep0(word)
+	goto step1
+
+step1: // Feminine
+	if n == 100 {
+		return word
+	}
+	n++
+	word, _ = portugueseStep1(word)
+	goto step2
+
+step2: // Augmentative
+	if n == 100 {
+		return word
+	}
+	n++
+	word, _ = portugueseStep2(word)
+	goto step3
+
+step3: // Adverb
+	if n == 100 {
+		return word
+	}
+	n++
+	word, _ = portugueseStep3(word)
+	goto step4
+
+step4: // Noun
+	if n == 100 {
+		return word
+	}
+	n++
+	if word, ok = portugueseStep4(word); ok {
+		return word
+	}
+	goto step5
+
+step5: // Verb
+	if n == 100 {
+		return word
+	}
+	n++
+	if word, ok = portugueseStep5(word); ok {
+		return word
+	}
+	goto step6
+
+step6: // Vowel
+	if n == 100 {
+		return word
+	}
+	n++
+	word, _ = portugueseStep6(word)
+	return word
+}
+
+// portugueseStep0 runs the "Plural" step.
+func portugueseStep0(word string) (string, bool) {
+	if len(word) < 3 {
+		return word, false
+	}
+	if !strings.HasSuffix(word, "s") {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 's':
+		if strings.HasSuffix(word, "ns") && len(word) >= 3 {
+			return word[:len(word)-2] + "m", true
+		}
+		if strings.HasSuffix(word, "ões") && len(word) >= 7 {
+			return word[:len(word)-4] + "ão", true
+		}
+		if strings.HasSuffix(word, "ães") && len(word) >= 5 && portugueseExceptions0_2[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-4] + "ão", true
+		}
+		if strings.HasSuffix(word, "ais") && len(word) >= 4 && portugueseExceptions0_3[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-3] + "al", true
+		}
+		if strings.HasSuffix(word, "éis") && len(word) >= 6 {
+			return word[:len(word)-4] + "el", true
+		}
+		if strings.HasSuffix(word, "eis") && len(word) >= 5 {
+			return word[:len(word)-3] + "el", true
+		}
+		if strings.HasSuffix(word, "óis") && len(word) >= 6 {
+			return word[:len(word)-4] + "ol", true
+		}
+		if strings.HasSuffix(word, "is") && len(word) >= 4 && portugueseExceptions0_7[portugueseHash(1, word)&31] != word {
+			return word[:len(word)-2] + "il", true
+		}
+		if strings.HasSuffix(word, "les") && len(word) >= 6 {
+			return word[:len(word)-3] + "l", true
+		}
+		if strings.HasSuffix(word, "res") && len(word) >= 6 {
+			return word[:len(word)-3] + "r", true
+		}
+		if len(word) >= 3 && portugueseExceptions0_10[portugueseHash(0, word)&127] != word {
+			return word[:len(word)-1], true
+		}
+	}
+	return word, false
+}
+
+// portugueseExceptions0_2 are the exceptions of the -ães rule, by their hash.
+var portugueseExceptions0_2 = [2]string{
+	1: "mãe",
+}
+
+// portugueseExceptions0_3 are the exceptions of the -ais rule, by their hash.
+var portugueseExceptions0_3 = [4]string{
+	1: "cais",
+	3: "mais",
+}
+
+// portugueseExceptions0_7 are the exceptions of the -is rule, by their hash.
+var portugueseExceptions0_7 = [32]string{
+	1:  "crúcis",
+	4:  "cais",
+	9:  "pois",
+	18: "mais",
+	19: "biquínis",
+	24: "lápis",
+	26: "depois",
+	27: "leis",
+	29: "dois",
+}
+
+// portugueseExceptions0_10 are the exceptions of the -s rule, by their hash.
+var portugueseExceptions0_10 = [128]string{
+	3:   "atrás",
+	5:   "pêsames",
+	10:  "fezes",
+	15:  "ambas",
+	19:  "após",
+	22:  "moisés",
+	24:  "aliás",
+	28:  "messias",
+	31:  "mais",
+	33:  "ês",
+	36:  "convés",
+	45:  "menos",
+	52:  "férias",
+	65:  "lápis",
+	75:  "gás",
+	83:  "país",
+	98:  "crúcis",
+	100: "pires",
+	105: "cais",
+	117: "ambos",
+	120: "mas",
+	122: "através",
+}
+
+// portugueseStep1 runs the "Feminine" step.
+func portugueseStep1(word string) (string, bool) {
+	if len(word) < 3 {
+		return word, false
+	}
+	if !strings.HasSuffix(word, "a") {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 'a':
+		if strings.HasSuffix(word, "ona") && len(word) >= 6 && portugueseExceptions1_0[portugueseHash(2, word)&31] != word {
+			return word[:len(word)-3] + "ão", true
+		}
+		if strings.HasSuffix(word, "ora") && len(word) >= 6 {
+			return word[:len(word)-3] + "or", true
+		}
+		if strings.HasSuffix(word, "na") && len(word) >= 6 && portugueseExceptions1_2[portugueseHash(12, word)&63] != word {
+			return word[:len(word)-2] + "no", true
+		}
+		if strings.HasSuffix(word, "inha") && len(word) >= 7 && portugueseExceptions1_3[portugueseHash(0, word)&7] != word {
+			return word[:len(word)-4] + "inho", true
+		}
+		if strings.HasSuffix(word, "esa") && len(word) >= 6 && portugueseExceptions1_4[portugueseHash(1, word)&15] != word {
+			return word[:len(word)-3] + "ês", true
+		}
+		if strings.HasSuffix(word, "osa") && len(word) >= 6 && portugueseExceptions1_5[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-3] + "oso", true
+		}
+		if strings.HasSuffix(word, "íaca") && len(word) >= 8 {
+			return word[:len(word)-5] + "íaco", true
+		}
+		if strings.HasSuffix(word, "ica") && len(word) >= 6 && portugueseExceptions1_7[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3] + "ico", true
+		}
+		if strings.HasSuffix(word, "ada") && len(word) >= 5 && portugueseExceptions1_8[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3] + "ado", true
+		}
+		if strings.HasSuffix(word, "ida") && len(word) >= 6 && portugueseExceptions1_9[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3] + "ido", true
+		}
+		if strings.HasSuffix(word, "ída") && len(word) >= 7 && portugueseExceptions1_10[portugueseHash(0, word)&7] != word {
+			return word[:len(word)-4] + "ido", true
+		}
+		if strings.HasSuffix(word, "ima") && len(word) >= 6 && portugueseExceptions1_11[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3] + "imo", true
+		}
+		if strings.HasSuffix(word, "iva") && len(word) >= 6 && portugueseExceptions1_12[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-3] + "ivo", true
+		}
+		if strings.HasSuffix(word, "eira") && len(word) >= 7 && portugueseExceptions1_13[portugueseHash(4, word)&31] != word {
+			return word[:len(word)-4] + "eiro", true
+		}
+	case 0xa3:
+		if strings.HasSuffix(word, "ã") && len(word) >= 4 && portugueseExceptions1_14[portugueseHash(1, word)&7] != word {
+			return word[:len(word)-2] + "ão", true
+		}
+	}
+	return word, false
+}
+
+// portugueseExceptions1_0 are the exceptions of the -ona rule, by their hash.
+var portugueseExceptions1_0 = [32]string{
+	1:  "carona",
+	5:  "monótona",
+	6:  "iona",
+	7:  "lona",
+	8:  "maratona",
+	13: "cortisona",
+	15: "abandona",
+	24: "detona",
+	30: "acetona",
+}
+
+// portugueseExceptions1_2 are the exceptions of the -na rule, by their hash.
+var portugueseExceptions1_2 = [64]string{
+	1:  "lona",
+	14: "guiana",
+	16: "acetona",
+	20: "banana",
+	26: "detona",
+	27: "carona",
+	28: "paisana",
+	32: "caravana",
+	34: "campana",
+	35: "cortisona",
+	38: "maratona",
+	41: "abandona",
+	51: "monótona",
+	56: "iona",
+	58: "grana",
+}
+
+// portugueseExceptions1_3 are the exceptions of the -inha rule, by their hash.
+var portugueseExceptions1_3 = [8]string{
+	0: "rainha",
+	3: "linha",
+	4: "minha",
+}
+
+// portugueseExceptions1_4 are the exceptions of the -esa rule, by their hash.
+var portugueseExceptions1_4 = [16]string{
+	1:  "presa",
+	4:  "ilesa",
+	8:  "turquesa",
+	9:  "princesa",
+	11: "pesa",
+	12: "obesa",
+	14: "mesa",
+}
+
+// portugueseExceptions1_5 are the exceptions of the -osa rule, by their hash.
+var portugueseExceptions1_5 = [4]string{
+	0: "prosa",
+	1: "mucosa",
+}
+
+// portugueseExceptions1_7 are the exceptions of the -ica rule, by their hash.
+var portugueseExceptions1_7 = [2]string{
+	0: "dica",
+}
+
+// portugueseExceptions1_8 are the exceptions of the -ada rule, by their hash.
+var portugueseExceptions1_8 = [2]string{
+	0: "pitada",
+}
+
+// portugueseExceptions1_9 are the exceptions of the -ida rule, by their hash.
+var portugueseExceptions1_9 = [2]string{
+	1: "vida",
+}
+
+// portugueseExceptions1_10 are the exceptions of the -ída rule, by their hash.
+var portugueseExceptions1_10 = [8]string{
+	2: "saída",
+	3: "recaída",
+	4: "dúvida",
+}
+
+// portugueseExceptions1_11 are the exceptions of the -ima rule, by their hash.
+var portugueseExceptions1_11 = [2]string{
+	0: "vítima",
+}
+
+// portugueseExceptions1_12 are the exceptions of the -iva rule, by their hash.
+var portugueseExceptions1_12 = [4]string{
+	0: "oliva",
+	3: "saliva",
+}
+
+// portugueseExceptions1_13 are the exceptions of the -eira rule, by their hash.
+var portugueseExceptions1_13 = [32]string{
+	3:  "capoeira",
+	7:  "frigideira",
+	8:  "cadeira",
+	10: "beira",
+	14: "feira",
+	15: "poeira",
+	23: "fronteira",
+	24: "besteira",
+	25: "bandeira",
+	31: "barreira",
+}
+
+// portugueseExceptions1_14 are the exceptions of the -ã rule, by their hash.
+var portugueseExceptions1_14 = [8]string{
+	1: "amanhã",
+	4: "fã",
+	5: "divã",
+	7: "arapuã",
+}
+
+// portugueseStep2 runs the "Augmentative" step.
+func portugueseStep2(word string) (string, bool) {
+	if word == "" {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 'a':
+		if strings.HasSuffix(word, "uça") && len(word) >= 8 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "aça") && len(word) >= 8 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "arra") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+	case 'o':
+		if strings.HasSuffix(word, "díssimo") && len(word) >= 13 {
+			return word[:len(word)-8], true
+		}
+		if strings.HasSuffix(word, "abilíssimo") && len(word) >= 16 {
+			return word[:len(word)-11], true
+		}
+		if strings.HasSuffix(word, "íssimo") && len(word) >= 10 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "ésimo") && len(word) >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "érrimo") && len(word) >= 11 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "zinho") && len(word) >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "quinho") && len(word) >= 10 {
+			return word[:len(word)-6] + "c", true
+		}
+		if strings.HasSuffix(word, "uinho") && len(word) >= 9 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "adinho") && len(word) >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "inho") && len(word) >= 7 && portugueseExceptions2_9[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "alhão") && len(word) >= 10 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "aço") && len(word) >= 8 && portugueseExceptions2_12[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "adão") && len(word) >= 9 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "idão") && len(word) >= 9 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ázio") && len(word) >= 8 && portugueseExceptions2_16[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "zarrão") && len(word) >= 10 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "arrão") && len(word) >= 10 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "zão") && len(word) >= 6 && portugueseExceptions2_21[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ão") && len(word) >= 6 && portugueseExceptions2_22[portugueseHash(1, word)&255] != word {
+			return word[:len(word)-3], true
+		}
+	case 'z':
+		if strings.HasSuffix(word, "arraz") && len(word) >= 9 {
+			return word[:len(word)-5], true
+		}
+	}
+	return word, false
+}
+
+// portugueseExceptions2_9 are the exceptions of the -inho rule, by their hash.
+var portugueseExceptions2_9 = [4]string{
+	0: "cominho",
+	2: "caminho",
+}
+
+// portugueseExceptions2_12 are the exceptions of the -aço rule, by their hash.
+var portugueseExceptions2_12 = [2]string{
+	1: "antebraço",
+}
+
+// portugueseExceptions2_16 are the exceptions of the -ázio rule, by their hash.
+var portugueseExceptions2_16 = [2]string{
+	0: "topázio",
+}
+
+// portugueseExceptions2_21 are the exceptions of the -zão rule, by their hash.
+var portugueseExceptions2_21 = [2]string{
+	0: "coalizão",
+}
+
+// portugueseExceptions2_22 are the exceptions of the -ão rule, by their hash.
+var portugueseExceptions2_22 = [256]string{
+	4:   "colchão",
+	14:  "gamão",
+	24:  "coração",
+	28:  "ilusão",
+	34:  "macacão",
+	48:  "patrão",
+	53:  "rincão",
+	54:  "leão",
+	62:  "tração",
+	66:  "cristão",
+	69:  "senão",
+	72:  "furacão",
+	90:  "espião",
+	93:  "leilão",
+	102: "nação",
+	108: "lampião",
+	109: "milhão",
+	119: "ficção",
+	120: "capitão",
+	129: "aptidão",
+	135: "fogão",
+	139: "falcão",
+	147: "cordão",
+	149: "feição",
+	160: "estação",
+	164: "embrião",
+	165: "orgão",
+	173: "órfão",
+	181: "limão",
+	183: "campeão",
+	186: "quinhão",
+	187: "chimarrão",
+	201: "glutão",
+	207: "camarão",
+	213: "grotão",
+	216: "mamão",
+	222: "portão",
+	231: "folião",
+	233: "fusão",
+	236: "bilhão",
+	240: "barão",
+	251: "canção",
+	253: "melão",
+}
+
+// portugueseStep3 runs the "Adverb" step.
+func portugueseStep3(word string) (string, bool) {
+	if word == "" {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 'e':
+		if strings.HasSuffix(word, "mente") && len(word) >= 9 && portugueseExceptions3_0[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-5], true
+		}
+	}
+	return word, false
+}
+
+// portugueseExceptions3_0 are the exceptions of the -mente rule, by their hash.
+var portugueseExceptions3_0 = [2]string{
+	1: "experimente",
+}
+
+// portugueseStep4 runs the "Noun" step.
+func portugueseStep4(word string) (string, bool) {
+	if word == "" {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 'a':
+		if strings.HasSuffix(word, "encialista") && len(word) >= 14 {
+			return word[:len(word)-10], true
+		}
+		if strings.HasSuffix(word, "alista") && len(word) >= 11 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "atória") && len(word) >= 12 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "icionista") && len(word) >= 13 {
+			return word[:len(word)-9], true
+		}
+		if strings.HasSuffix(word, "cionista") && len(word) >= 13 {
+			return word[:len(word)-8], true
+		}
+		if strings.HasSuffix(word, "ionista") && len(word) >= 12 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "ência") && len(word) >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "ância") && len(word) >= 10 && portugueseExceptions4_32[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "eza") && len(word) >= 6 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "oria") && len(word) >= 8 && portugueseExceptions4_62[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ista") && len(word) >= 8 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "auta") && len(word) >= 9 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ura") && len(word) >= 7 && portugueseExceptions4_77[portugueseHash(0, word)&7] != word {
+			return word[:len(word)-3], true
+		}
+	case 'e':
+		if strings.HasSuffix(word, "abilidade") && len(word) >= 14 {
+			return word[:len(word)-9], true
+		}
+		if strings.HasSuffix(word, "ante") && len(word) >= 6 && portugueseExceptions4_53[portugueseHash(1, word)&15] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ividade") && len(word) >= 12 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "idade") && len(word) >= 9 && portugueseExceptions4_61[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "quice") && len(word) >= 9 {
+			return word[:len(word)-5] + "c", true
+		}
+		if strings.HasSuffix(word, "ice") && len(word) >= 7 && portugueseExceptions4_67[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ente") && len(word) >= 8 && portugueseExceptions4_69[portugueseHash(1, word)&15] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ense") && len(word) >= 9 {
+			return word[:len(word)-4], true
+		}
+	case 'l':
+		if strings.HasSuffix(word, "ional") && len(word) >= 9 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "encial") && len(word) >= 11 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "inal") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ável") && len(word) >= 7 && portugueseExceptions4_73[portugueseHash(0, word)&7] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ível") && len(word) >= 8 && portugueseExceptions4_74[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "vel") && len(word) >= 8 && portugueseExceptions4_75[portugueseHash(0, word)&7] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "bil") && len(word) >= 6 {
+			return word[:len(word)-3] + "vel", true
+		}
+		if strings.HasSuffix(word, "ural") && len(word) >= 8 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ual") && len(word) >= 6 && portugueseExceptions4_79[portugueseHash(0, word)&7] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ial") && len(word) >= 6 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "al") && len(word) >= 6 && portugueseExceptions4_81[portugueseHash(3, word)&63] != word {
+			return word[:len(word)-2], true
+		}
+	case 'm':
+		if strings.HasSuffix(word, "agem") && len(word) >= 7 && portugueseExceptions4_2[portugueseHash(2, word)&7] != word {
+			return word[:len(word)-4], true
+		}
+	case 'o':
+		if strings.HasSuffix(word, "ático") && len(word) >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "iamento") && len(word) >= 11 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "amento") && len(word) >= 9 && portugueseExceptions4_5[portugueseHash(0, word)&7] != word {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "imento") && len(word) >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "mento") && len(word) >= 11 && portugueseExceptions4_7[portugueseHash(0, word)&15] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "alizado") && len(word) >= 11 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "atizado") && len(word) >= 11 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "tizado") && len(word) >= 10 && portugueseExceptions4_10[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "izado") && len(word) >= 10 && portugueseExceptions4_11[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ativo") && len(word) >= 9 && portugueseExceptions4_12[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "tivo") && len(word) >= 8 && portugueseExceptions4_13[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ivo") && len(word) >= 7 && portugueseExceptions4_14[portugueseHash(1, word)&7] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ado") && len(word) >= 5 && portugueseExceptions4_15[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ido") && len(word) >= 6 && portugueseExceptions4_16[portugueseHash(1, word)&31] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "edouro") && len(word) >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "queiro") && len(word) >= 9 {
+			return word[:len(word)-6] + "c", true
+		}
+		if strings.HasSuffix(word, "adeiro") && len(word) >= 10 && portugueseExceptions4_35[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "eiro") && len(word) >= 7 && portugueseExceptions4_36[portugueseHash(0, word)&7] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "uoso") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "oso") && len(word) >= 6 && portugueseExceptions4_38[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ário") && len(word) >= 8 && portugueseExceptions4_45[portugueseHash(5, word)&15] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "atório") && len(word) >= 10 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "ário") && len(word) >= 10 && portugueseExceptions4_47[portugueseHash(0, word)&31] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ério") && len(word) >= 11 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "esco") && len(word) >= 8 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ástico") && len(word) >= 11 && portugueseExceptions4_54[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "alístico") && len(word) >= 12 {
+			return word[:len(word)-9], true
+		}
+		if strings.HasSuffix(word, "áutico") && len(word) >= 11 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "êutico") && len(word) >= 11 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "tico") && len(word) >= 7 && portugueseExceptions4_58[portugueseHash(3, word)&63] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ico") && len(word) >= 7 && portugueseExceptions4_59[portugueseHash(1, word)&7] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "íaco") && len(word) >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ano") && len(word) >= 7 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "alismo") && len(word) >= 10 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "ivismo") && len(word) >= 10 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "ismo") && len(word) >= 7 && portugueseExceptions4_84[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+	case 'r':
+		if strings.HasSuffix(word, "ador") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "edor") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "idor") && len(word) >= 8 && portugueseExceptions4_19[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "dor") && len(word) >= 7 && portugueseExceptions4_20[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "sor") && len(word) >= 7 && portugueseExceptions4_21[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "tor") && len(word) >= 6 && portugueseExceptions4_23[portugueseHash(0, word)&15] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "or") && len(word) >= 4 && portugueseExceptions4_24[portugueseHash(6, word)&63] != word {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "ionar") && len(word) >= 10 {
+			return word[:len(word)-5], true
+		}
+	case 's':
+		if strings.HasSuffix(word, "ês") && len(word) >= 7 {
+			return word[:len(word)-3], true
+		}
+	case 'z':
+		if strings.HasSuffix(word, "ez") && len(word) >= 6 {
+			return word[:len(word)-2], true
+		}
+	case 0xa7:
+		if strings.HasSuffix(word, "alizaç") && len(word) >= 12 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "atizaç") && len(word) >= 12 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "tizaç") && len(word) >= 11 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "izaç") && len(word) >= 10 && portugueseExceptions4_42[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "aç") && len(word) >= 6 && portugueseExceptions4_43[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "iç") && len(word) >= 6 && portugueseExceptions4_44[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+	}
+	return word, false
+}
+
+// portugueseExceptions4_32 are the exceptions of the -ância rule, by their hash.
+var portugueseExceptions4_32 = [2]string{
+	0: "ambulância",
+}
+
+// portugueseExceptions4_62 are the exceptions of the -oria rule, by their hash.
+var portugueseExceptions4_62 = [2]string{
+	0: "categoria",
+}
+
+// portugueseExceptions4_77 are the exceptions of the -ura rule, by their hash.
+var portugueseExceptions4_77 = [8]string{
+	0: "imatura",
+	2: "costura",
+	5: "acupuntura",
+}
+
+// portugueseExceptions4_53 are the exceptions of the -ante rule, by their hash.
+var portugueseExceptions4_53 = [16]string{
+	0:  "instante",
+	2:  "restaurante",
+	9:  "gigante",
+	10: "elefante",
+	12: "adiante",
+	15: "possante",
+}
+
+// portugueseExceptions4_61 are the exceptions of the -idade rule, by their hash.
+var portugueseExceptions4_61 = [4]string{
+	0: "comunidade",
+	3: "autoridade",
+}
+
+// portugueseExceptions4_67 are the exceptions of the -ice rule, by their hash.
+var portugueseExceptions4_67 = [2]string{
+	1: "cúmplice",
+}
+
+// portugueseExceptions4_69 are the exceptions of the -ente rule, by their hash.
+var portugueseExceptions4_69 = [16]string{
+	0:  "oriente",
+	3:  "alimente",
+	9:  "freqüente",
+	13: "permanente",
+	14: "aparente",
+	15: "acrescente",
+}
+
+// portugueseExceptions4_73 are the exceptions of the -ável rule, by their hash.
+var portugueseExceptions4_73 = [8]string{
+	2: "razoável",
+	4: "vulnerável",
+	5: "afável",
+	7: "potável",
+}
+
+// portugueseExceptions4_74 are the exceptions of the -ível rule, by their hash.
+var portugueseExceptions4_74 = [2]string{
+	1: "possível",
+}
+
+// portugueseExceptions4_75 are the exceptions of the -vel rule, by their hash.
+var portugueseExceptions4_75 = [8]string{
+	1: "possível",
+	4: "vulnerável",
+	7: "solúvel",
+}
+
+// portugueseExceptions4_79 are the exceptions of the -ual rule, by their hash.
+var portugueseExceptions4_79 = [8]string{
+	0: "pontual",
+	1: "visual",
+	3: "bissexual",
+	4: "virtual",
+}
+
+// portugueseExceptions4_81 are the exceptions of the -al rule, by their hash.
+var portugueseExceptions4_81 = [64]string{
+	2:  "animal",
+	5:  "virtual",
+	6:  "estatal",
+	8:  "sucursal",
+	10: "bissexual",
+	16: "sideral",
+	20: "fiscal",
+	21: "pontual",
+	23: "afinal",
+	28: "desleal",
+	37: "postal",
+	41: "pessoal",
+	42: "visual",
+	43: "liberal",
+	61: "formal",
+}
+
+// portugueseExceptions4_2 are the exceptions of the -agem rule, by their hash.
+var portugueseExceptions4_2 = [8]string{
+	3: "chantagem",
+	4: "carruagem",
+	6: "vantagem",
+	7: "coragem",
+}
+
+// portugueseExceptions4_5 are the exceptions of the -amento rule, by their hash.
+var portugueseExceptions4_5 = [8]string{
+	1: "firmamento",
+	3: "departamento",
+	6: "fundamento",
+}
+
+// portugueseExceptions4_7 are the exceptions of the -mento rule, by their hash.
+var portugueseExceptions4_7 = [16]string{
+	1:  "firmamento",
+	6:  "complemento",
+	8:  "elemento",
+	11: "departamento",
+	13: "instrumento",
+}
+
+// portugueseExceptions4_10 are the exceptions of the -tizado rule, by their hash.
+var portugueseExceptions4_10 = [2]string{
+	1: "alfabetizado",
+}
+
+// portugueseExceptions4_11 are the exceptions of the -izado rule, by their hash.
+var portugueseExceptions4_11 = [4]string{
+	1: "organizado",
+	2: "pulverizado",
+}
+
+// portugueseExceptions4_12 are the exceptions of the -ativo rule, by their hash.
+var portugueseExceptions4_12 = [4]string{
+	0: "pejorativo",
+	3: "relativo",
+}
+
+// portugueseExceptions4_13 are the exceptions of the -tivo rule, by their hash.
+var portugueseExceptions4_13 = [2]string{
+	1: "relativo",
+}
+
+// portugueseExceptions4_14 are the exceptions of the -ivo rule, by their hash.
+var portugueseExceptions4_14 = [8]string{
+	1: "pejorativo",
+	3: "passivo",
+	4: "possessivo",
+	5: "positivo",
+}
+
+// portugueseExceptions4_15 are the exceptions of the -ado rule, by their hash.
+var portugueseExceptions4_15 = [2]string{
+	0: "grado",
+}
+
+// portugueseExceptions4_16 are the exceptions of the -ido rule, by their hash.
+var portugueseExceptions4_16 = [32]string{
+	2:  "decido",
+	3:  "tímido",
+	8:  "cândido",
+	16: "rápido",
+	18: "marido",
+	24: "consolido",
+	27: "duvido",
+}
+
+// portugueseExceptions4_35 are the exceptions of the -adeiro rule, by their hash.
+var portugueseExceptions4_35 = [2]string{
+	0: "desfiladeiro",
+}
+
+// portugueseExceptions4_36 are the exceptions of the -eiro rule, by their hash.
+var portugueseExceptions4_36 = [8]string{
+	2: "pioneiro",
+	6: "desfiladeiro",
+	7: "mosteiro",
+}
+
+// portugueseExceptions4_38 are the exceptions of the -oso rule, by their hash.
+var portugueseExceptions4_38 = [2]string{
+	1: "precioso",
+}
+
+// portugueseExceptions4_45 are the exceptions of the -ário rule, by their hash.
+var portugueseExceptions4_45 = [16]string{
+	0:  "lionário",
+	4:  "voluntário",
+	8:  "armário",
+	10: "salário",
+	14: "aniversário",
+	15: "diário",
+}
+
+// portugueseExceptions4_47 are the exceptions of the -ário rule, by their hash.
+var portugueseExceptions4_47 = [32]string{
+	5:  "armário",
+	7:  "próprio",
+	8:  "compulsório",
+	15: "salário",
+	23: "voluntário",
+	24: "stério",
+	27: "aniversário",
+	28: "diário",
+	31: "lionário",
+}
+
+// portugueseExceptions4_54 are the exceptions of the -ástico rule, by their hash.
+var portugueseExceptions4_54 = [2]string{
+	0: "eclesiástico",
+}
+
+// portugueseExceptions4_58 are the exceptions of the -tico rule, by their hash.
+var portugueseExceptions4_58 = [64]string{
+	9:  "prático",
+	14: "crítico",
+	17: "diagnóstico",
+	18: "doméstico",
+	20: "político",
+	29: "alopático",
+	36: "autêntico",
+	41: "idêntico",
+	50: "diagnostico",
+	51: "critico",
+	55: "eclesiástico",
+	59: "eclético",
+	61: "artístico",
+}
+
+// portugueseExceptions4_59 are the exceptions of the -ico rule, by their hash.
+var portugueseExceptions4_59 = [8]string{
+	0: "público",
+	5: "tico",
+	6: "explico",
+}
+
+// portugueseExceptions4_84 are the exceptions of the -ismo rule, by their hash.
+var portugueseExceptions4_84 = [2]string{
+	1: "cinismo",
+}
+
+// portugueseExceptions4_19 are the exceptions of the -idor rule, by their hash.
+var portugueseExceptions4_19 = [2]string{
+	1: "ouvidor",
+}
+
+// portugueseExceptions4_20 are the exceptions of the -dor rule, by their hash.
+var portugueseExceptions4_20 = [2]string{
+	1: "ouvidor",
+}
+
+// portugueseExceptions4_21 are the exceptions of the -sor rule, by their hash.
+var portugueseExceptions4_21 = [2]string{
+	0: "assessor",
+}
+
+// portugueseExceptions4_23 are the exceptions of the -tor rule, by their hash.
+var portugueseExceptions4_23 = [16]string{
+	1:  "promotor",
+	3:  "benfeitor",
+	4:  "produtor",
+	6:  "consultor",
+	8:  "editor",
+	12: "pastor",
+	14: "leitor",
+}
+
+// portugueseExceptions4_24 are the exceptions of the -or rule, by their hash.
+var portugueseExceptions4_24 = [64]string{
+	14: "tumor",
+	24: "motor",
+	25: "favor",
+	31: "redor",
+	38: "pastor",
+	42: "rigor",
+	44: "terior",
+	48: "assessor",
+	49: "benfeitor",
+	50: "tambor",
+	56: "melhor",
+	61: "sensor",
+	62: "autor",
+}
+
+// portugueseExceptions4_42 are the exceptions of the -izaç rule, by their hash.
+var portugueseExceptions4_42 = [2]string{
+	0: "organizaç",
+}
+
+// portugueseExceptions4_43 are the exceptions of the -aç rule, by their hash.
+var portugueseExceptions4_43 = [4]string{
+	1: "relaç",
+	3: "equaç",
+}
+
+// portugueseExceptions4_44 are the exceptions of the -iç rule, by their hash.
+var portugueseExceptions4_44 = [2]string{
+	1: "eleição",
+}
+
+// portugueseStep5 runs the "Verb" step.
+func portugueseStep5(word string) (string, bool) {
+	if word == "" {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 'a':
+		if strings.HasSuffix(word, "aria") && len(word) >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "eria") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "iria") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "iava") && len(word) >= 8 && portugueseExceptions5_62[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "iona") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ara") && len(word) >= 5 && portugueseExceptions5_65[portugueseHash(0, word)&15] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ava") && len(word) >= 5 && portugueseExceptions5_68[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "era") && len(word) >= 6 && portugueseExceptions5_70[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ira") && len(word) >= 6 && portugueseExceptions5_76[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "uía") && len(word) >= 9 {
+			return word[:len(word)-4] + "u", true
+		}
+		if strings.HasSuffix(word, "ia") && len(word) >= 5 && portugueseExceptions5_95[portugueseHash(12, word)&31] != word {
+			return word[:len(word)-2], true
+		}
+	case 'e':
+		if strings.HasSuffix(word, "arde") && len(word) >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "asse") && len(word) >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "aste") && len(word) >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "erde") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "esse") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "este") && len(word) >= 7 && portugueseExceptions5_50[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "irde") && len(word) >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "isse") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "iste") && len(word) >= 8 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "are") && len(word) >= 5 && portugueseExceptions5_67[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ere") && len(word) >= 6 && portugueseExceptions5_72[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ire") && len(word) >= 6 && portugueseExceptions5_82[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-3], true
+		}
+	case 'i':
+		if strings.HasSuffix(word, "árei") && len(word) >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "aríei") && len(word) >= 8 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "ássei") && len(word) >= 8 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "eríei") && len(word) >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "êssei") && len(word) >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "iríei") && len(word) >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "íssei") && len(word) >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "arei") && len(word) >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ávei") && len(word) >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "erei") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "êrei") && len(word) >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "irei") && len(word) >= 7 && portugueseExceptions5_56[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "íei") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ai") && len(word) >= 4 {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "uei") && len(word) >= 6 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ei") && len(word) >= 5 {
+			return word[:len(word)-2], true
+		}
+		if len(word) >= 4 {
+			return word[:len(word)-1], true
+		}
+	case 'm':
+		if strings.HasSuffix(word, "ariam") && len(word) >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "assem") && len(word) >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "eriam") && len(word) >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "essem") && len(word) >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "iriam") && len(word) >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "issem") && len(word) >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "aram") && len(word) >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "arem") && len(word) >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "avam") && len(word) >= 6 && portugueseExceptions5_39[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "eram") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "erem") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "iram") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "íram") && len(word) >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "irem") && len(word) >= 7 && portugueseExceptions5_57[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "iam") && len(word) >= 6 && portugueseExceptions5_73[portugueseHash(0, word)&7] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "am") && len(word) >= 4 {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "guem") && len(word) >= 7 {
+			return word[:len(word)-4] + "g", true
+		}
+		if strings.HasSuffix(word, "em") && len(word) >= 4 && portugueseExceptions5_92[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-2], true
+		}
+	case 'o':
+		if strings.HasSuffix(word, "aríamo") && len(word) >= 9 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "ássemo") && len(word) >= 9 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "eríamo") && len(word) >= 9 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "êssemo") && len(word) >= 9 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "iríamo") && len(word) >= 10 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "íssemo") && len(word) >= 10 {
+			return word[:len(word)-7], true
+		}
+		if strings.HasSuffix(word, "áramo") && len(word) >= 8 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "aremo") && len(word) >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ávamo") && len(word) >= 8 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "êramo") && len(word) >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "eremo") && len(word) >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "íramo") && len(word) >= 9 {
+			return word[:len(word)-6], true
+		}
+		if strings.HasSuffix(word, "iremo") && len(word) >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ando") && len(word) >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "endo") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "indo") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ondo") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "arão") && len(word) >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "armo") && len(word) >= 6 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "erão") && len(word) >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "ermo") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "íamo") && len(word) >= 8 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "irão") && len(word) >= 7 {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "irmo") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "amo") && len(word) >= 5 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "emo") && len(word) >= 5 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "imo") && len(word) >= 6 && portugueseExceptions5_75[portugueseHash(1, word)&15] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ído") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "omo") && len(word) >= 6 {
+			return word[:len(word)-3], true
+		}
+	case 'r':
+		if strings.HasSuffix(word, "tizar") && len(word) >= 9 && portugueseExceptions5_79[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-5], true
+		}
+		if strings.HasSuffix(word, "izar") && len(word) >= 9 && portugueseExceptions5_80[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "itar") && len(word) >= 9 && portugueseExceptions5_81[portugueseHash(2, word)&15] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "ear") && len(word) >= 7 && portugueseExceptions5_86[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ar") && len(word) >= 4 && portugueseExceptions5_87[portugueseHash(0, word)&7] != word {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "er") && len(word) >= 4 && portugueseExceptions5_93[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "ir") && len(word) >= 5 && portugueseExceptions5_96[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-2], true
+		}
+	case 'u':
+		if strings.HasSuffix(word, "eu") && len(word) >= 5 && portugueseExceptions5_94[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "iu") && len(word) >= 5 {
+			return word[:len(word)-2], true
+		}
+		if strings.HasSuffix(word, "eou") && len(word) >= 8 {
+			return word[:len(word)-3], true
+		}
+		if strings.HasSuffix(word, "ou") && len(word) >= 5 {
+			return word[:len(word)-2], true
+		}
+	case 0xa1:
+		if strings.HasSuffix(word, "ará") && len(word) >= 6 && portugueseExceptions5_66[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "erá") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+		if strings.HasSuffix(word, "irá") && len(word) >= 7 {
+			return word[:len(word)-4], true
+		}
+	}
+	return word, false
+}
+
+// portugueseExceptions5_62 are the exceptions of the -iava rule, by their hash.
+var portugueseExceptions5_62 = [2]string{
+	0: "ampliava",
+}
+
+// portugueseExceptions5_65 are the exceptions of the -ara rule, by their hash.
+var portugueseExceptions5_65 = [16]string{
+	0: "prepara",
+	8: "arara",
+}
+
+// portugueseExceptions5_68 are the exceptions of the -ava rule, by their hash.
+var portugueseExceptions5_68 = [2]string{
+	1: "agrava",
+}
+
+// portugueseExceptions5_70 are the exceptions of the -era rule, by their hash.
+var portugueseExceptions5_70 = [4]string{
+	2: "acelera",
+	3: "espera",
+}
+
+// portugueseExceptions5_76 are the exceptions of the -ira rule, by their hash.
+var portugueseExceptions5_76 = [4]string{
+	0: "sátira",
+	3: "fronteira",
+}
+
+// portugueseExceptions5_95 are the exceptions of the -ia rule, by their hash.
+var portugueseExceptions5_95 = [32]string{
+	1:  "lábia",
+	4:  "elogia",
+	7:  "polícia",
+	11: "estória",
+	15: "arredia",
+	20: "aprecia",
+	21: "mania",
+	24: "ásia",
+	27: "acia",
+	28: "praia",
+	30: "fatia",
+	31: "cheia",
+}
+
+// portugueseExceptions5_50 are the exceptions of the -este rule, by their hash.
+var portugueseExceptions5_50 = [4]string{
+	0: "faroeste",
+	2: "agreste",
+}
+
+// portugueseExceptions5_67 are the exceptions of the -are rule, by their hash.
+var portugueseExceptions5_67 = [2]string{
+	0: "prepare",
+}
+
+// portugueseExceptions5_72 are the exceptions of the -ere rule, by their hash.
+var portugueseExceptions5_72 = [2]string{
+	1: "espere",
+}
+
+// portugueseExceptions5_82 are the exceptions of the -ire rule, by their hash.
+var portugueseExceptions5_82 = [2]string{
+	0: "adquire",
+}
+
+// portugueseExceptions5_56 are the exceptions of the -irei rule, by their hash.
+var portugueseExceptions5_56 = [2]string{
+	0: "admirei",
+}
+
+// portugueseExceptions5_39 are the exceptions of the -avam rule, by their hash.
+var portugueseExceptions5_39 = [2]string{
+	0: "agravam",
+}
+
+// portugueseExceptions5_57 are the exceptions of the -irem rule, by their hash.
+var portugueseExceptions5_57 = [2]string{
+	1: "adquirem",
+}
+
+// portugueseExceptions5_73 are the exceptions of the -iam rule, by their hash.
+var portugueseExceptions5_73 = [8]string{
+	3: "ensaiam",
+	4: "ampliam",
+	5: "enfiam",
+	7: "elogiam",
+}
+
+// portugueseExceptions5_92 are the exceptions of the -em rule, by their hash.
+var portugueseExceptions5_92 = [4]string{
+	1: "virgem",
+	2: "alem",
+}
+
+// portugueseExceptions5_75 are the exceptions of the -imo rule, by their hash.
+var portugueseExceptions5_75 = [16]string{
+	0:  "queimo",
+	1:  "íntimo",
+	3:  "ximo",
+	4:  "intimo",
+	5:  "nimo",
+	14: "reprimo",
+}
+
+// portugueseExceptions5_79 are the exceptions of the -tizar rule, by their hash.
+var portugueseExceptions5_79 = [2]string{
+	0: "alfabetizar",
+}
+
+// portugueseExceptions5_80 are the exceptions of the -izar rule, by their hash.
+var portugueseExceptions5_80 = [2]string{
+	0: "organizar",
+}
+
+// portugueseExceptions5_81 are the exceptions of the -itar rule, by their hash.
+var portugueseExceptions5_81 = [16]string{
+	0:  "explicitar",
+	8:  "acreditar",
+	14: "estreitar",
+}
+
+// portugueseExceptions5_86 are the exceptions of the -ear rule, by their hash.
+var portugueseExceptions5_86 = [4]string{
+	1: "nuclear",
+	3: "alardear",
+}
+
+// portugueseExceptions5_87 are the exceptions of the -ar rule, by their hash.
+var portugueseExceptions5_87 = [8]string{
+	1: "azar",
+	6: "bazaar",
+	7: "patamar",
+}
+
+// portugueseExceptions5_93 are the exceptions of the -er rule, by their hash.
+var portugueseExceptions5_93 = [4]string{
+	0: "éter",
+	1: "pier",
+}
+
+// portugueseExceptions5_96 are the exceptions of the -ir rule, by their hash.
+var portugueseExceptions5_96 = [2]string{
+	1: "freir",
+}
+
+// portugueseExceptions5_94 are the exceptions of the -eu rule, by their hash.
+var portugueseExceptions5_94 = [2]string{
+	1: "chapeu",
+}
+
+// portugueseExceptions5_66 are the exceptions of the -ará rule, by their hash.
+var portugueseExceptions5_66 = [2]string{
+	1: "alvará",
+}
+
+// portugueseStep6 runs the "Vowel" step.
+func portugueseStep6(word string) (string, bool) {
+	if word == "" {
+		return word, false
+	}
+	switch word[len(word)-1] {
+	case 'a':
+		if len(word) >= 4 && portugueseExceptions6_4[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-1], true
+		}
+	case 'e':
+		if strings.HasSuffix(word, "gue") && len(word) >= 5 && portugueseExceptions6_1[portugueseHash(0, word)&3] != word {
+			return word[:len(word)-3] + "g", true
+		}
+		if len(word) >= 4 {
+			return word[:len(word)-1], true
+		}
+	case 'l':
+		if strings.HasSuffix(word, "bil") && len(word) >= 5 {
+			return word[:len(word)-3] + "vel", true
+		}
+	case 'o':
+		if len(word) >= 4 && portugueseExceptions6_6[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-1], true
+		}
+	case 0xa1:
+		if strings.HasSuffix(word, "á") && len(word) >= 5 {
+			return word[:len(word)-2], true
+		}
+	case 0xaa:
+		if strings.HasSuffix(word, "ê") && len(word) >= 5 && portugueseExceptions6_3[portugueseHash(0, word)&1] != word {
+			return word[:len(word)-2], true
+		}
+	}
+	return word, false
+}
+
+// portugueseExceptions6_4 are the exceptions of the -a rule, by their hash.
+var portugueseExceptions6_4 = [2]string{
+	0: "ásia",
+}
+
+// portugueseExceptions6_1 are the exceptions of the -gue rule, by their hash.
+var portugueseExceptions6_1 = [4]string{
+	0: "gangue",
+	3: "jegue",
+}
+
+// portugueseExceptions6_6 are the exceptions of the -o rule, by their hash.
+var portugueseExceptions6_6 = [2]string{
+	0: "ão",
+}
+
+// portugueseExceptions6_3 are the exceptions of the -ê rule, by their hash.
+var portugueseExceptions6_3 = [2]string{
+	1: "bebê",
+}
+
+// portugueseHash is the FNV-1a hash of s, seeded with seed.
+func portugueseHash(seed uint32, s string) uint32 {
+	h := 2166136261 ^ seed
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= 16777619
+	}
+	return h
+}
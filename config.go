@@ -0,0 +1,78 @@
+package rslp
+
+import "fmt"
+
+// Config is the serializable form of Options, as read from JSON by services
+// running the stemmer. The rule set and the stopwords are given by name.
+type Config struct {
+	// Rules is the name of a built-in rule set, or of one of the rule sets
+	// passed to Options. Empty selects the default rule set.
+	Rules string `json:"rules,omitempty"`
+
+	KeepDiacritics     bool `json:"keep_diacritics,omitempty"`
+	PreserveCase       bool `json:"preserve_case,omitempty"`
+	Orthography        bool `json:"orthography,omitempty"`
+	DetachClitics      bool `json:"detach_clitics,omitempty"`
+	ExpandContractions bool `json:"expand_contractions,omitempty"`
+
+	// Stopwords is the name of a built-in stopword list, "pt-br" or
+	// "pt-pt". Empty selects none.
+	Stopwords string `json:"stopwords,omitempty"`
+}
+
+// builtinRuleSetList are the built-in rule sets, looked up by name.
+var builtinRuleSetList = []*RuleSet{Portuguese, PortugueseExtended, LucenePortuguese, Galician}
+
+// BuiltinRuleSets returns the built-in rule sets.
+func BuiltinRuleSets() []*RuleSet {
+	return append([]*RuleSet(nil), builtinRuleSetList...)
+}
+
+// BuiltinRules returns the built-in rule set with the given name, or nil if
+// there is none.
+func BuiltinRules(name string) *RuleSet {
+	for _, rs := range builtinRuleSetList {
+		if rs.Name() == name {
+			return rs
+		}
+	}
+	return nil
+}
+
+// Options returns the options of the config. The rule set is looked up by
+// name among the given rule sets and then among the built-in ones.
+func (c Config) Options(rules ...*RuleSet) (Options, error) {
+	o := Options{
+		KeepDiacritics:     c.KeepDiacritics,
+		PreserveCase:       c.PreserveCase,
+		Orthography:        c.Orthography,
+		DetachClitics:      c.DetachClitics,
+		ExpandContractions: c.ExpandContractions,
+	}
+
+	if c.Rules != "" {
+		for _, rs := range rules {
+			if rs.Name() == c.Rules {
+				o.Rules = rs
+				break
+			}
+		}
+		if o.Rules == nil {
+			o.Rules = BuiltinRules(c.Rules)
+		}
+		if o.Rules == nil {
+			return o, fmt.Errorf("rslp: unknown rule set %q", c.Rules)
+		}
+	}
+
+	switch c.Stopwords {
+	case "":
+	case BrazilianStopwords.Name():
+		o.Stopwords = BrazilianStopwords
+	case EuropeanStopwords.Name():
+		o.Stopwords = EuropeanStopwords
+	default:
+		return o, fmt.Errorf("rslp: unknown stopword list %q", c.Stopwords)
+	}
+	return o, nil
+}
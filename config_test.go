@@ -0,0 +1,49 @@
+package rslp
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestConfig(t *testing.T) {
+	var c Config
+	if err := json.Unmarshal([]byte(`{"rules": "galician", "keep_diacritics": true, "orthography": true, "stopwords": "pt-pt"}`), &c); err != nil {
+		t.Fatal(err)
+	}
+	o, err := c.Options()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Options{Rules: Galician, KeepDiacritics: true, Orthography: true, Stopwords: EuropeanStopwords}
+	if o != want {
+		t.Fatalf("invalid options\nwant %+v\n got %+v", want, o)
+	}
+
+	o, err = Config{}.Options()
+	if err != nil || o != (Options{}) {
+		t.Fatalf("invalid default options %+v, %v", o, err)
+	}
+
+	custom := Portuguese.clone()
+	custom.name = "custom"
+	if o, err := (Config{Rules: "custom"}).Options(custom); err != nil || o.Rules != custom {
+		t.Fatalf("the custom rule set was not selected: %v", err)
+	}
+
+	for _, c := range []Config{{Rules: "custom"}, {Stopwords: "pt"}} {
+		if _, err := c.Options(); err == nil {
+			t.Fatalf("expected an error for %+v", c)
+		}
+	}
+}
+
+func TestBuiltinRules(t *testing.T) {
+	for _, rs := range BuiltinRuleSets() {
+		if got := BuiltinRules(rs.Name()); got != rs {
+			t.Fatalf("%s: invalid built-in rule set %v", rs.Name(), got)
+		}
+	}
+	if got := BuiltinRules("custom"); got != nil {
+		t.Fatalf("unexpected built-in rule set %q", got.Name())
+	}
+}
@@ -0,0 +1,78 @@
+package rslp
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// contractions maps the contractions of prepositions with articles,
+// pronouns and adverbs to their components.
+var contractions = map[string][]string{
+	// a
+	"ao": {"a", "o"}, "aos": {"a", "os"}, "à": {"a", "a"}, "às": {"a", "as"},
+	"àquele": {"a", "aquele"}, "àquela": {"a", "aquela"}, "àqueles": {"a", "aqueles"},
+	"àquelas": {"a", "aquelas"}, "àquilo": {"a", "aquilo"}, "aonde": {"a", "onde"},
+
+	// de
+	"do": {"de", "o"}, "da": {"de", "a"}, "dos": {"de", "os"}, "das": {"de", "as"},
+	"dum": {"de", "um"}, "duma": {"de", "uma"}, "duns": {"de", "uns"}, "dumas": {"de", "umas"},
+	"dele": {"de", "ele"}, "dela": {"de", "ela"}, "deles": {"de", "eles"}, "delas": {"de", "elas"},
+	"deste": {"de", "este"}, "desta": {"de", "esta"}, "destes": {"de", "estes"}, "destas": {"de", "estas"},
+	"disto": {"de", "isto"},
+	"desse": {"de", "esse"}, "dessa": {"de", "essa"}, "desses": {"de", "esses"}, "dessas": {"de", "essas"},
+	"disso": {"de", "isso"},
+	"daquele": {"de", "aquele"}, "daquela": {"de", "aquela"}, "daqueles": {"de", "aqueles"},
+	"daquelas": {"de", "aquelas"}, "daquilo": {"de", "aquilo"},
+	"doutro": {"de", "outro"}, "doutra": {"de", "outra"}, "doutros": {"de", "outros"}, "doutras": {"de", "outras"},
+	"daqui": {"de", "aqui"}, "daí": {"de", "aí"}, "dali": {"de", "ali"}, "donde": {"de", "onde"},
+
+	// em
+	"no": {"em", "o"}, "na": {"em", "a"}, "nos": {"em", "os"}, "nas": {"em", "as"},
+	"num": {"em", "um"}, "numa": {"em", "uma"}, "nuns": {"em", "uns"}, "numas": {"em", "umas"},
+	"nele": {"em", "ele"}, "nela": {"em", "ela"}, "neles": {"em", "eles"}, "nelas": {"em", "elas"},
+	"neste": {"em", "este"}, "nesta": {"em", "esta"}, "nestes": {"em", "estes"}, "nestas": {"em", "estas"},
+	"nisto": {"em", "isto"},
+	"nesse": {"em", "esse"}, "nessa": {"em", "essa"}, "nesses": {"em", "esses"}, "nessas": {"em", "essas"},
+	"nisso": {"em", "isso"},
+	"naquele": {"em", "aquele"}, "naquela": {"em", "aquela"}, "naqueles": {"em", "aqueles"},
+	"naquelas": {"em", "aquelas"}, "naquilo": {"em", "aquilo"},
+	"noutro": {"em", "outro"}, "noutra": {"em", "outra"}, "noutros": {"em", "outros"}, "noutras": {"em", "outras"},
+
+	// por
+	"pelo": {"por", "o"}, "pela": {"por", "a"}, "pelos": {"por", "os"}, "pelas": {"por", "as"},
+}
+
+// expandContraction splits a contraction into its components, keeping the
+// casing of the word and the punctuation around it: "Daquele," becomes
+// "De" and "aquele,". Other words are returned alone.
+func expandContraction(word string) []string {
+	start := strings.IndexFunc(word, unicode.IsLetter)
+	end := strings.LastIndexFunc(word, unicode.IsLetter)
+	if start < 0 {
+		return []string{word}
+	}
+	_, size := utf8.DecodeRuneInString(word[end:])
+	end += size
+
+	token := word[start:end]
+	parts, ok := contractions[strings.ToLower(token)]
+	if !ok {
+		return []string{word}
+	}
+
+	upper := strings.ToUpper(token) == token
+	expanded := make([]string, len(parts))
+	for i, p := range parts {
+		switch {
+		case upper:
+			p = strings.ToUpper(p)
+		case i == 0:
+			p = restoreCase(token, p)
+		}
+		expanded[i] = p
+	}
+	expanded[0] = word[:start] + expanded[0]
+	expanded[len(expanded)-1] += word[end:]
+	return expanded
+}
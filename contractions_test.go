@@ -0,0 +1,58 @@
+package rslp
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestExpandContraction(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"do", "de o"},
+		{"nas", "em as"},
+		{"pelo", "por o"},
+		{"num", "em um"},
+		{"daquele", "de aquele"},
+		{"àquela", "a aquela"},
+		{"Daquele", "De aquele"},
+		{"NAS", "EM AS"},
+		{"(pelo", "(por o"},
+		{"disso.", "de isso."},
+		{"casa", "casa"},
+		{"...", "..."},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := strings.Join(expandContraction(tt.input), " ")
+
+			if tt.want != got {
+				t.Fatalf("invalid expansion output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStemSentenceExpandContractions(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Voltou do trabalho pelas ruas daquela cidade.", "volt de o trabalh por as rua de aquel cidade."},
+		{"Pensou  nisso   num domingo", "pens em iss em um doming"},
+	}
+
+	opts := Options{ExpandContractions: true}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := opts.StemSentence(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
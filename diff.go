@@ -0,0 +1,184 @@
+package rslp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// StemChange is a word whose stem differs between two stemmers.
+type StemChange struct {
+	Word   string `json:"word"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// DiffGroup is a group of changed words for which the same rule is
+// responsible.
+type DiffGroup struct {
+	// Rule is the first rule whose outcome differs between the two rule
+	// sets, as "Step/index (-suffix)" in the rule set it belongs to,
+	// prefixed by "+" for the new rule set and "-" for the old one. It is
+	// empty when the rules agree and only the normalization differs.
+	Rule    string       `json:"rule"`
+	Changes []StemChange `json:"changes"`
+}
+
+// RuleDiff is the effect of replacing a stemmer with another on a
+// vocabulary.
+type RuleDiff struct {
+	Words   int         `json:"words"` // distinct words compared
+	Changed int         `json:"changed"`
+	Groups  []DiffGroup `json:"groups"`
+
+	// Merged is the number of conflation classes of the new stemmer made of
+	// words from several classes of the old one, and Split the number of
+	// classes of the old stemmer whose words are in several classes of the
+	// new one.
+	Merged int `json:"merged"`
+	Split  int `json:"split"`
+}
+
+// ruleTrace is the outcome of a rule on a word, as recorded by a tracer.
+type ruleTrace struct {
+	step   string
+	r      *rule
+	event  ruleEvent
+	before string
+	after  string
+}
+
+// trace stems a word with the options, recording the outcome of every rule
+// whose suffix matched it.
+func (o Options) trace(word string) (string, []ruleTrace) {
+	var traces []ruleTrace
+	stem, _ := o.stem(strings.ToValidUTF8(word, string(utf8.RuneError)), func(step string, r *rule, event ruleEvent, before, after string) {
+		traces = append(traces, ruleTrace{step, r, event, before, after})
+	})
+	return stem, traces
+}
+
+// DiffRules stems the words with the options from and to, usually with
+// different rule sets, and reports the words whose stem changed, grouped by
+// the rule responsible for the change, from the largest group to the
+// smallest.
+func DiffRules(from, to Options, words []string) *RuleDiff {
+	oldPositions, newPositions := from.rules().positions(), to.rules().positions()
+	label := func(sign string, positions map[*rule]rulePosition, t ruleTrace) string {
+		pos := positions[t.r]
+		return fmt.Sprintf("%s%s/%d (-%s)", sign, pos.step, pos.index, t.r.suffix)
+	}
+	same := func(a, b ruleTrace) bool {
+		oa, ob := oldPositions[a.r], newPositions[b.r]
+		return oa == ob && a.event == b.event && a.before == b.before &&
+			a.r.suffix == b.r.suffix && a.r.replacement == b.r.replacement
+	}
+
+	d := &RuleDiff{}
+	groups := map[string]*DiffGroup{}
+	oldClasses, newClasses := map[string][]string{}, map[string][]string{}
+	oldStems := map[string]string{}
+
+	seen := map[string]bool{}
+	for _, word := range words {
+		if seen[word] {
+			continue
+		}
+		seen[word] = true
+		d.Words++
+
+		before, oldTraces := from.trace(word)
+		after, newTraces := to.trace(word)
+		oldClasses[before] = append(oldClasses[before], word)
+		newClasses[after] = append(newClasses[after], word)
+		oldStems[word] = before
+		if before == after {
+			continue
+		}
+		d.Changed++
+
+		i := 0
+		for i < len(oldTraces) && i < len(newTraces) && same(oldTraces[i], newTraces[i]) {
+			i++
+		}
+		var rule string
+		if i < len(newTraces) {
+			rule = label("+", newPositions, newTraces[i])
+		} else if i < len(oldTraces) {
+			rule = label("-", oldPositions, oldTraces[i])
+		}
+
+		g := groups[rule]
+		if g == nil {
+			g = &DiffGroup{Rule: rule}
+			groups[rule] = g
+		}
+		g.Changes = append(g.Changes, StemChange{word, before, after})
+	}
+
+	for _, g := range groups {
+		d.Groups = append(d.Groups, *g)
+	}
+	sort.Slice(d.Groups, func(i, j int) bool {
+		a, b := d.Groups[i], d.Groups[j]
+		return len(a.Changes) > len(b.Changes) || len(a.Changes) == len(b.Changes) && a.Rule < b.Rule
+	})
+
+	newStems := map[string]string{}
+	for stem, class := range newClasses {
+		for _, word := range class {
+			newStems[word] = stem
+		}
+	}
+	// count the classes whose words have more than one stem in the other
+	// stemmer.
+	spread := func(classes map[string][]string, stems map[string]string) int {
+		n := 0
+		for _, class := range classes {
+			for _, word := range class[1:] {
+				if stems[word] != stems[class[0]] {
+					n++
+					break
+				}
+			}
+		}
+		return n
+	}
+	d.Merged = spread(newClasses, oldStems)
+	d.Split = spread(oldClasses, newStems)
+	return d
+}
+
+// WriteText writes a summary of the differences followed by the changed
+// words of each group, as "word: before -> after".
+func (d *RuleDiff) WriteText(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d of %d words changed, %d classes merged, %d split\n", d.Changed, d.Words, d.Merged, d.Split)
+	for _, g := range d.Groups {
+		rule := g.Rule
+		if rule == "" {
+			rule = "normalization"
+		}
+		if len(g.Changes) == 1 {
+			fmt.Fprintf(&b, "\n%s: 1 word\n", rule)
+		} else {
+			fmt.Fprintf(&b, "\n%s: %d words\n", rule, len(g.Changes))
+		}
+		for _, c := range g.Changes {
+			fmt.Fprintf(&b, "\t%s: %s -> %s\n", c.Word, c.Before, c.After)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteJSON writes the differences as a JSON object.
+func (d *RuleDiff) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(d)
+}
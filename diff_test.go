@@ -0,0 +1,89 @@
+package rslp
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testDiff(t *testing.T) *RuleDiff {
+	rs, err := LoadRules("test", strings.NewReader(suggestRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	changed, err := rs.Apply(
+		Suggestion{Step: "Feminine", Rule: 0, Suffix: "ona", MinLength: 1},
+		Suggestion{Step: "Plural", Rule: 0, Suffix: "s", Exception: "pelos"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	words := []string{"pelos", "pelo", "gatos", "gato", "leona", "leão", "dona", "dão", "pelos"}
+	return DiffRules(Options{Rules: rs}, Options{Rules: changed}, words)
+}
+
+func TestDiffRules(t *testing.T) {
+	want := &RuleDiff{
+		Words:   8,
+		Changed: 3,
+		Groups: []DiffGroup{
+			{Rule: "+Feminine/0 (-ona)", Changes: []StemChange{{"leona", "leona", "leao"}, {"dona", "dona", "dao"}}},
+			{Rule: "+Plural/0 (-s)", Changes: []StemChange{{"pelos", "pelo", "pelos"}}},
+		},
+		Merged: 2,
+		Split:  1,
+	}
+	if got := testDiff(t); !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid diff\nwant %+v\n got %+v", want, got)
+	}
+}
+
+func TestDiffRulesSame(t *testing.T) {
+	d := DiffRules(Options{}, Options{}, []string{"casas", "casa", "felicidade"})
+	if d.Words != 3 || d.Changed != 0 || len(d.Groups) != 0 || d.Merged != 0 || d.Split != 0 {
+		t.Fatalf("invalid diff %+v", d)
+	}
+}
+
+func TestDiffRulesNormalization(t *testing.T) {
+	d := DiffRules(Options{}, Options{KeepDiacritics: true}, []string{"canções"})
+	want := []DiffGroup{{Changes: []StemChange{{"canções", "canca", "cançã"}}}}
+	if !reflect.DeepEqual(d.Groups, want) {
+		t.Fatalf("invalid groups\nwant %+v\n got %+v", want, d.Groups)
+	}
+}
+
+func TestDiffWrite(t *testing.T) {
+	d := testDiff(t)
+
+	var buf bytes.Buffer
+	if err := d.WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `3 of 8 words changed, 2 classes merged, 1 split
+
++Feminine/0 (-ona): 2 words
+	leona: leona -> leao
+	dona: dona -> dao
+
++Plural/0 (-s): 1 word
+	pelos: pelo -> pelos
+`
+	if buf.String() != want {
+		t.Fatalf("invalid text\nwant %s\n got %s", want, buf.String())
+	}
+
+	buf.Reset()
+	if err := d.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var got RuleDiff
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, d) {
+		t.Fatalf("invalid JSON\nwant %+v\n got %+v", d, got)
+	}
+}
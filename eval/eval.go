@@ -0,0 +1,199 @@
+// Package eval measures the quality of a stemmer using the method proposed
+// by Chris D. Paice in "Method for evaluation of stemming algorithms based on
+// error counting" (JASIS, 1996).
+//
+// The stemmer is run over groups of morphologically related words that
+// should share a stem. Words of a group left with different stems are
+// counted as understemming errors, words of different groups conflated to
+// the same stem as overstemming errors.
+package eval
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// Group is a set of morphologically related words that should share a stem.
+type Group []string
+
+// ReadGroups reads the word groups from r. Each line holds a group with its
+// words separated by white space. Blank lines and lines starting with '#'
+// are ignored.
+func ReadGroups(r io.Reader) ([]Group, error) {
+	var groups []Group
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		groups = append(groups, Group(strings.Fields(line)))
+	}
+	return groups, scanner.Err()
+}
+
+// Result holds the evaluation of a stemmer over a set of word groups.
+type Result struct {
+	Words int // number of words evaluated
+	Stems int // number of distinct stems produced
+
+	GDMT float64 // global desired merge total
+	GUMT float64 // global unachieved merge total
+	GDNT float64 // global desired non-merge total
+	GWMT float64 // global wrongly-merged total
+
+	UI   float64 // understemming index, GUMT / GDMT
+	OI   float64 // overstemming index, GWMT / GDNT
+	SW   float64 // stemming weight, OI / UI
+	ERRT float64 // error rate relative to truncation
+
+	// ICF is the index compression factor, (Words - Stems) / Words.
+	ICF float64
+
+	// MeanClassSize is the mean number of words per conflation class,
+	// Words / Stems.
+	MeanClassSize float64
+}
+
+// Evaluate runs stem over every word of groups and computes the Paice
+// indices of the result. The words of all groups are expected to be
+// distinct.
+func Evaluate(groups []Group, stem func(word string) string) Result {
+	res := indices(groups, stem)
+
+	if res.UI > 0 {
+		res.SW = res.OI / res.UI
+	} else if res.OI > 0 {
+		res.SW = math.Inf(1)
+	}
+	res.ERRT = errt(res.UI, res.OI, truncationLine(groups))
+
+	if res.Words > 0 {
+		res.ICF = float64(res.Words-res.Stems) / float64(res.Words)
+	}
+	if res.Stems > 0 {
+		res.MeanClassSize = float64(res.Words) / float64(res.Stems)
+	}
+	return res
+}
+
+// indices computes the merge totals and the UI and OI indices.
+func indices(groups []Group, stem func(word string) string) Result {
+	var res Result
+
+	// classes maps every stem to the number of its words in each group.
+	classes := map[string]map[int]int{}
+
+	for g, group := range groups {
+		res.Words += len(group)
+
+		stems := map[string]int{}
+		for _, word := range group {
+			s := stem(word)
+			stems[s]++
+
+			if classes[s] == nil {
+				classes[s] = map[int]int{}
+			}
+			classes[s][g]++
+		}
+
+		n := float64(len(group))
+		res.GDMT += 0.5 * n * (n - 1)
+		for _, u := range stems {
+			res.GUMT += 0.5 * float64(u) * (n - float64(u))
+		}
+	}
+
+	for _, group := range groups {
+		n := float64(len(group))
+		res.GDNT += 0.5 * n * (float64(res.Words) - n)
+	}
+
+	for _, class := range classes {
+		v := 0
+		for _, count := range class {
+			v += count
+		}
+		for _, count := range class {
+			res.GWMT += 0.5 * float64(count) * float64(v-count)
+		}
+	}
+	res.Stems = len(classes)
+
+	if res.GDMT > 0 {
+		res.UI = res.GUMT / res.GDMT
+	}
+	if res.GDNT > 0 {
+		res.OI = res.GWMT / res.GDNT
+	}
+	return res
+}
+
+// point is a (UI, OI) pair.
+type point struct {
+	ui, oi float64
+}
+
+// truncationLine returns the (UI, OI) points of the stemmers truncating the
+// words to 1, 2, ... runes, up to the length of the longest word.
+func truncationLine(groups []Group) []point {
+	longest := 0
+	for _, group := range groups {
+		for _, word := range group {
+			if n := utf8.RuneCountInString(word); n > longest {
+				longest = n
+			}
+		}
+	}
+
+	line := make([]point, 0, longest)
+	for n := 1; n <= longest; n++ {
+		res := indices(groups, func(word string) string {
+			return truncate(word, n)
+		})
+		line = append(line, point{res.UI, res.OI})
+	}
+	return line
+}
+
+// truncate returns the first n runes of word.
+func truncate(word string, n int) string {
+	for i := range word {
+		if n == 0 {
+			return word[:i]
+		}
+		n--
+	}
+	return word
+}
+
+// errt returns the ratio between the distance from the origin to (ui, oi)
+// and the distance from the origin to the truncation line along the same
+// direction. It returns NaN if the direction does not cross the line.
+func errt(ui, oi float64, line []point) float64 {
+	if ui == 0 && oi == 0 {
+		return 0
+	}
+
+	for i := 1; i < len(line); i++ {
+		a, b := line[i-1], line[i]
+
+		// solve t*(ui, oi) = a + s*(b - a) for t > 0 and s in [0, 1].
+		dx, dy := b.ui-a.ui, b.oi-a.oi
+		det := ui*dy - oi*dx
+		if det == 0 {
+			continue
+		}
+		t := (a.ui*dy - a.oi*dx) / det
+		s := (a.ui*oi - a.oi*ui) / det
+		if t > 0 && s >= 0 && s <= 1 {
+			return 1 / t
+		}
+	}
+	return math.NaN()
+}
@@ -0,0 +1,113 @@
+package eval
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/knuppe/rslp"
+)
+
+func TestReadGroups(t *testing.T) {
+	input := `
+# verbs
+cantar cantando  cantou
+
+casa	casas
+`
+	groups, err := ReadGroups(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Group{{"cantar", "cantando", "cantou"}, {"casa", "casas"}}
+	if fmt.Sprint(groups) != fmt.Sprint(want) {
+		t.Fatalf("invalid groups, want %q (got %q)", want, groups)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	groups := []Group{{"w1", "w2", "w3"}, {"w4", "w5"}}
+	stems := map[string]string{"w1": "A", "w2": "A", "w3": "B", "w4": "B", "w5": "C"}
+
+	got := Evaluate(groups, func(word string) string { return stems[word] })
+
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"GDMT", got.GDMT, 4},
+		{"GUMT", got.GUMT, 3},
+		{"GDNT", got.GDNT, 6},
+		{"GWMT", got.GWMT, 1},
+		{"UI", got.UI, 0.75},
+		{"OI", got.OI, 1.0 / 6},
+		{"SW", got.SW, 2.0 / 9},
+		{"ICF", got.ICF, 0.4},
+		{"MeanClassSize", got.MeanClassSize, 5.0 / 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Abs(tt.want-tt.got) > 1e-9 {
+				t.Fatalf("invalid %s, want %v (got %v)", tt.name, tt.want, tt.got)
+			}
+		})
+	}
+
+	if got.Words != 5 || got.Stems != 3 {
+		t.Fatalf("invalid counts, want 5 words and 3 stems (got %d and %d)", got.Words, got.Stems)
+	}
+}
+
+func TestERRT(t *testing.T) {
+	line := []point{{0, 1}, {0.5, 0.5}, {1, 0}}
+
+	tests := []struct {
+		ui, oi float64
+		want   float64
+	}{
+		{0, 0, 0},
+		{0.25, 0.25, 0.5},
+		{0.5, 0.5, 1},
+		{0.1, 0.3, 0.4},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			if got := errt(tt.ui, tt.oi, line); math.Abs(tt.want-got) > 1e-9 {
+				t.Fatalf("invalid ERRT of (%v, %v), want %v (got %v)", tt.ui, tt.oi, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestEvaluateRSLP(t *testing.T) {
+	groups := []Group{
+		{"cantar", "cantando", "cantou", "cantaremos"},
+		{"casa", "casas", "casinha"},
+		{"menino", "menina", "meninos", "meninas"},
+	}
+
+	got := Evaluate(groups, rslp.Options{}.Stem)
+
+	if got.UI != 0 || got.OI != 0 {
+		t.Fatalf("invalid indices, want no errors (got UI %v, OI %v)", got.UI, got.OI)
+	}
+	if got.Stems != 3 {
+		t.Fatalf("invalid number of stems, want 3 (got %d)", got.Stems)
+	}
+}
+
+func ExampleEvaluate() {
+	groups, _ := ReadGroups(strings.NewReader(`
+cantar cantando cantou
+casa casas casinha
+`))
+
+	res := Evaluate(groups, rslp.Options{}.Stem)
+	fmt.Printf("UI=%.2f OI=%.2f ICF=%.2f\n", res.UI, res.OI, res.ICF)
+	// Output: UI=0.00 OI=0.00 ICF=0.67
+}
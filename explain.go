@@ -0,0 +1,69 @@
+package rslp
+
+// RuleOutcome is the outcome of a rule whose suffix matched a word, as
+// reported by Explain.
+type RuleOutcome struct {
+	Step        string `json:"step"`
+	Rule        int    `json:"rule"` // index of the rule in its step
+	Suffix      string `json:"suffix"`
+	Replacement string `json:"replacement"`
+
+	// Outcome is "applied" when the rule changed the word, "exception"
+	// when one of its exceptions blocked it and "length" when the word was
+	// too short for it.
+	Outcome string `json:"outcome"`
+
+	// Exception is the exception that blocked the rule, if any.
+	Exception string `json:"exception,omitempty"`
+
+	// Before and After are the word before and after the rule, equal unless
+	// the rule was applied.
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// Explanation describes how a word was stemmed.
+type Explanation struct {
+	Word string `json:"word"`
+	Stem string `json:"stem"`
+
+	// Rules are the outcomes of the rules whose suffix matched the word, in
+	// the order they were tried.
+	Rules []RuleOutcome `json:"rules"`
+}
+
+// outcomes are the names of the rule events in a RuleOutcome.
+var outcomes = map[ruleEvent]string{
+	ruleApplied:   "applied",
+	ruleException: "exception",
+	ruleLength:    "length",
+}
+
+// Explain stems a word like Stem does, and reports the outcome of each rule
+// whose suffix matched it on the way.
+func (o Options) Explain(word string) Explanation {
+	rs := o.rules()
+	positions := rs.positions()
+
+	stem, traces := o.trace(word)
+	e := Explanation{Word: word, Stem: stem, Rules: make([]RuleOutcome, len(traces))}
+	for i, t := range traces {
+		ro := RuleOutcome{
+			Step:        t.step,
+			Rule:        positions[t.r].index,
+			Suffix:      t.r.suffix,
+			Replacement: t.r.replacement,
+			Outcome:     outcomes[t.event],
+			Before:      t.before,
+			After:       t.before,
+		}
+		switch t.event {
+		case ruleApplied:
+			ro.After = t.after
+		case ruleException:
+			ro.Exception = t.r.exception(t.before, rs.steps[t.step].entireWord, o.Orthography)
+		}
+		e.Rules[i] = ro
+	}
+	return e
+}
@@ -0,0 +1,52 @@
+package rslp
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	got := Options{}.Explain("Lápis")
+	want := Explanation{
+		Word: "Lápis",
+		Stem: "lapis",
+		Rules: []RuleOutcome{
+			{Step: "Plural", Rule: 7, Suffix: "is", Replacement: "il", Outcome: "exception", Exception: "lápis", Before: "lápis", After: "lápis"},
+			{Step: "Plural", Rule: 10, Suffix: "s", Outcome: "exception", Exception: "lápis", Before: "lápis", After: "lápis"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid explanation\nwant %+v\n got %+v", want, got)
+	}
+
+	got = Options{KeepDiacritics: true}.Explain("meninas")
+	want = Explanation{
+		Word: "meninas",
+		Stem: "menin",
+		Rules: []RuleOutcome{
+			{Step: "Plural", Rule: 10, Suffix: "s", Outcome: "applied", Before: "meninas", After: "menina"},
+			{Step: "Feminine", Rule: 2, Suffix: "na", Replacement: "no", Outcome: "applied", Before: "menina", After: "menino"},
+			{Step: "Vowel", Rule: 6, Suffix: "o", Outcome: "applied", Before: "menino", After: "menin"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid explanation\nwant %+v\n got %+v", want, got)
+	}
+
+	if got := (Options{}).Explain("sol"); got.Stem != "sol" || got.Rules == nil || len(got.Rules) != 0 {
+		t.Fatalf("invalid explanation of a short word %+v", got)
+	}
+}
+
+func TestExplainLength(t *testing.T) {
+	rs, err := LoadRules("test", strings.NewReader(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := Options{Rules: rs}.Explain("beira")
+	want := []RuleOutcome{{Step: "Feminine", Rule: 1, Suffix: "eira", Replacement: "eiro", Outcome: "length", Before: "beira", After: "beira"}}
+	if !reflect.DeepEqual(got.Rules, want) {
+		t.Fatalf("invalid outcomes\nwant %+v\n got %+v", want, got.Rules)
+	}
+}
@@ -2,4 +2,7 @@ module github.com/knuppe/rslp
 
 go 1.17
 
-require golang.org/x/text v0.3.7
+require (
+	golang.org/x/text v0.3.7
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,194 @@
+package rslp
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/transform"
+)
+
+// The golden vocabulary lives in testdata/golden:
+//
+//   - vocabulary.tsv holds the words and the stems this package produced
+//     when it was generated by gen.go; any change there is a regression.
+//   - every other .tsv file holds the stems of a reference implementation
+//     for the same words, such as nltk.tsv written by nltk.py or rslp-c.tsv
+//     for the original C implementation.
+//   - known.txt lists the intentional differences from the references.
+//
+//go:embed testdata/golden/*.tsv testdata/golden/known.txt
+var golden embed.FS
+
+// goldenEntry is a word of the golden vocabulary and its expected stem.
+type goldenEntry struct {
+	word string
+	want string
+}
+
+func readGolden(t *testing.T, name string) []goldenEntry {
+	data, err := golden.ReadFile(path.Join("testdata/golden", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var entries []goldenEntry
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Split(text, "\t")
+		if len(fields) != 2 {
+			t.Fatalf("%s:%d: want 2 fields (got %d)", name, line, len(fields))
+		}
+		entries = append(entries, goldenEntry{fields[0], fields[1]})
+	}
+	return entries
+}
+
+// readKnown returns the known differences of each reference, mapping the
+// words to the reason of the difference.
+func readKnown(t *testing.T) map[string]map[string]string {
+	data, err := golden.ReadFile("testdata/golden/known.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	known := map[string]map[string]string{}
+	for i, text := range strings.Split(string(data), "\n") {
+		if strings.TrimSpace(text) == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.SplitN(text, "\t", 3)
+		if len(fields) != 3 {
+			t.Fatalf("known.txt:%d: want 3 fields (got %d)", i+1, len(fields))
+		}
+		if known[fields[0]] == nil {
+			known[fields[0]] = map[string]string{}
+		}
+		known[fields[0]][fields[1]] = fields[2]
+	}
+	return known
+}
+
+// responsibleRule stems the word and describes the last rule applied to it,
+// which is blamed for any divergence.
+func responsibleRule(word string) (string, string) {
+	blame := "no rule applied"
+	stem, _ := Options{}.stem(word, func(step string, r *rule, event ruleEvent, before, after string) {
+		if event == ruleApplied {
+			blame = fmt.Sprintf("%s: -%s -> %q", step, r.suffix, r.replacement)
+		}
+	})
+	return stem, blame
+}
+
+// checkGolden compares the stems of the entries with the expected ones,
+// reporting the divergences grouped by the rule responsible for them. When
+// fold is set, differences in diacritics only are not divergences.
+func checkGolden(t *testing.T, entries []goldenEntry, known map[string]string, fold bool) {
+	divergences := map[string][]string{}
+	expected := 0
+
+	for _, e := range entries {
+		got, blame := responsibleRule(e.word)
+
+		want := e.want
+		if fold {
+			want, _, _ = transform.String(normalize, want)
+		}
+		if got == want {
+			if _, ok := known[e.word]; ok {
+				t.Errorf("%q is listed as a known difference but matches %q", e.word, want)
+			}
+			continue
+		}
+		if _, ok := known[e.word]; ok {
+			expected++
+			continue
+		}
+		divergences[blame] = append(divergences[blame], fmt.Sprintf("%s -> %s (want %s)", e.word, got, want))
+	}
+
+	rules := make([]string, 0, len(divergences))
+	for r := range divergences {
+		rules = append(rules, r)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if a, b := len(divergences[rules[i]]), len(divergences[rules[j]]); a != b {
+			return a > b
+		}
+		return rules[i] < rules[j]
+	})
+
+	for _, r := range rules {
+		t.Errorf("%s: %d divergences\n\t%s", r, len(divergences[r]), strings.Join(divergences[r], "\n\t"))
+	}
+	if expected > 0 {
+		t.Logf("%d known differences", expected)
+	}
+}
+
+// goldenReferences are the reference implementations whose stems of the
+// golden vocabulary are compared with this package, and how their .tsv file
+// is produced. The comparison is skipped until the file is checked in.
+var goldenReferences = []struct {
+	name, generate string
+}{
+	{"nltk", "python3 testdata/golden/nltk.py < testdata/golden/vocabulary.tsv > testdata/golden/nltk.tsv"},
+	{"rslp-c", "run the original C RSLP stemmer over the words of vocabulary.tsv, writing \"word<TAB>stem\" lines to testdata/golden/rslp-c.tsv"},
+}
+
+func TestGolden(t *testing.T) {
+	t.Run("vocabulary", func(t *testing.T) {
+		entries := readGolden(t, "vocabulary.tsv")
+		if len(entries) < 10000 {
+			t.Fatalf("the golden vocabulary is too small (%d words)", len(entries))
+		}
+		checkGolden(t, entries, nil, false)
+	})
+
+	known := readKnown(t)
+	for source := range known {
+		if !isGoldenReference(source) {
+			t.Errorf("known.txt lists differences from the unknown reference %q", source)
+		}
+	}
+
+	for _, ref := range goldenReferences {
+		ref := ref
+		t.Run(ref.name, func(t *testing.T) {
+			if _, err := fs.Stat(golden, "testdata/golden/"+ref.name+".tsv"); err != nil {
+				t.Skipf("no %s.tsv; to compare with %s, %s", ref.name, ref.name, ref.generate)
+			}
+			entries := readGolden(t, ref.name+".tsv")
+			words := make(map[string]bool, len(entries))
+			for _, e := range entries {
+				words[e.word] = true
+			}
+			for word := range known[ref.name] {
+				if !words[word] {
+					t.Errorf("known.txt lists %q, which %s.tsv doesn't hold", word, ref.name)
+				}
+			}
+			checkGolden(t, entries, known[ref.name], true)
+		})
+	}
+}
+
+func isGoldenReference(name string) bool {
+	for _, ref := range goldenReferences {
+		if ref.name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+package rslp
+
+//go:generate go run ./cmd/rslp graph -o steps.dot
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// GraphOptions configures the step graphs written by WriteDOT and
+// WriteMermaid.
+type GraphOptions struct {
+	// RuleCounts adds the number of rules to the label of each step.
+	RuleCounts bool
+
+	// Rules adds an edge from each step to each of its rules.
+	Rules bool
+}
+
+// graphEdge is an edge of the step graph. An empty label is drawn as an
+// unlabeled edge.
+type graphEdge struct {
+	from, to, label string
+}
+
+// graphEnd is the name of the node the flow ends in.
+const graphEnd = "end"
+
+// edges returns the edges of the flow between the steps, starting with the
+// edge from the start node. The pass and fail edges of a step going to the
+// same step are merged.
+func (rs *RuleSet) edges() []graphEdge {
+	target := func(name string) string {
+		if rs.steps[name] == nil {
+			return graphEnd
+		}
+		return name
+	}
+
+	edges := []graphEdge{{"start", rs.start, ""}}
+	for _, name := range rs.order {
+		s := rs.steps[name]
+		pass, fail := target(s.stepPass), target(s.stepFail)
+		if pass == fail {
+			edges = append(edges, graphEdge{name, pass, ""})
+			continue
+		}
+		edges = append(edges, graphEdge{name, pass, "pass"}, graphEdge{name, fail, "fail"})
+	}
+	return edges
+}
+
+// stepLabel returns the label of a step in the graphs.
+func (rs *RuleSet) stepLabel(name string, opts GraphOptions) string {
+	if !opts.RuleCounts {
+		return name
+	}
+	n := len(rs.steps[name].rules)
+	if n == 1 {
+		return fmt.Sprintf("%s (1 rule)", name)
+	}
+	return fmt.Sprintf("%s (%d rules)", name, n)
+}
+
+// ruleLabel returns the label of a rule in the graphs.
+func ruleLabel(r *rule) string {
+	if r.replacement == "" {
+		return "-" + r.suffix
+	}
+	return fmt.Sprintf("-%s → %s", r.suffix, r.replacement)
+}
+
+// WriteDOT writes the graph of the steps of the rule set, and the flow
+// between them, in the DOT language of Graphviz.
+func (rs *RuleSet) WriteDOT(w io.Writer, opts GraphOptions) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", rs.name)
+	b.WriteString("\tnode [shape=box];\n")
+	fmt.Fprintf(&b, "\t%q [shape=circle];\n", "start")
+	fmt.Fprintf(&b, "\t%q [shape=doublecircle];\n", graphEnd)
+	for _, name := range rs.order {
+		fmt.Fprintf(&b, "\t%q [label=%q];\n", name, rs.stepLabel(name, opts))
+	}
+	for _, e := range rs.edges() {
+		if e.label == "" {
+			fmt.Fprintf(&b, "\t%q -> %q;\n", e.from, e.to)
+		} else {
+			fmt.Fprintf(&b, "\t%q -> %q [label=%q];\n", e.from, e.to, e.label)
+		}
+	}
+	if opts.Rules {
+		for _, name := range rs.order {
+			for i := range rs.steps[name].rules {
+				id := fmt.Sprintf("%s/%d", name, i)
+				fmt.Fprintf(&b, "\t%q [label=%q, shape=plaintext];\n", id, ruleLabel(&rs.steps[name].rules[i]))
+				fmt.Fprintf(&b, "\t%q -> %q [style=dashed, arrowhead=none];\n", name, id)
+			}
+		}
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// mermaidText escapes the quotes of a label of Mermaid.
+func mermaidText(text string) string {
+	return strings.ReplaceAll(text, `"`, "#quot;")
+}
+
+// WriteMermaid writes the graph of the steps of the rule set, and the flow
+// between them, as a Mermaid flowchart.
+func (rs *RuleSet) WriteMermaid(w io.Writer, opts GraphOptions) error {
+	// the steps are identified by their index, since their names may not be
+	// valid identifiers, and "end" is a keyword of Mermaid.
+	ids := map[string]string{"start": "start", graphEnd: "stop"}
+	for i, name := range rs.order {
+		ids[name] = fmt.Sprintf("s%d", i)
+	}
+
+	var b strings.Builder
+	b.WriteString("flowchart TD\n")
+	b.WriteString("\tstart((start))\n")
+	fmt.Fprintf(&b, "\tstop(((%s)))\n", graphEnd)
+	for _, name := range rs.order {
+		fmt.Fprintf(&b, "\t%s[\"%s\"]\n", ids[name], mermaidText(rs.stepLabel(name, opts)))
+	}
+	for _, e := range rs.edges() {
+		if e.label == "" {
+			fmt.Fprintf(&b, "\t%s --> %s\n", ids[e.from], ids[e.to])
+		} else {
+			fmt.Fprintf(&b, "\t%s -->|%s| %s\n", ids[e.from], e.label, ids[e.to])
+		}
+	}
+	if opts.Rules {
+		for _, name := range rs.order {
+			for i := range rs.steps[name].rules {
+				fmt.Fprintf(&b, "\t%s -.- %s_%d[\"%s\"]\n", ids[name], ids[name], i, mermaidText(ruleLabel(&rs.steps[name].rules[i])))
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
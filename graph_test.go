@@ -0,0 +1,106 @@
+package rslp
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestWriteDOTSteps(t *testing.T) {
+	want, err := os.ReadFile("steps.dot")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Portuguese.WriteDOT(&buf, GraphOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != string(want) {
+		t.Fatalf("steps.dot is out of date, run go generate\n%s", buf.String())
+	}
+}
+
+func TestWriteDOT(t *testing.T) {
+	rs, err := LoadRules("test", strings.NewReader(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := rs.WriteDOT(&buf, GraphOptions{RuleCounts: true, Rules: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `digraph "test" {
+	node [shape=box];
+	"start" [shape=circle];
+	"end" [shape=doublecircle];
+	"Plural" [label="Plural (2 rules)"];
+	"Feminine" [label="Feminine (2 rules)"];
+	"Vowel" [label="Vowel (1 rule)"];
+	"start" -> "Plural";
+	"Plural" -> "Feminine";
+	"Feminine" -> "end" [label="pass"];
+	"Feminine" -> "Vowel" [label="fail"];
+	"Vowel" -> "end";
+	"Plural/0" [label="-ns → m", shape=plaintext];
+	"Plural" -> "Plural/0" [style=dashed, arrowhead=none];
+	"Plural/1" [label="-s", shape=plaintext];
+	"Plural" -> "Plural/1" [style=dashed, arrowhead=none];
+	"Feminine/0" [label="-ona → ão", shape=plaintext];
+	"Feminine" -> "Feminine/0" [style=dashed, arrowhead=none];
+	"Feminine/1" [label="-eira → eiro", shape=plaintext];
+	"Feminine" -> "Feminine/1" [style=dashed, arrowhead=none];
+	"Vowel/0" [label="-o", shape=plaintext];
+	"Vowel" -> "Vowel/0" [style=dashed, arrowhead=none];
+}
+`
+	if buf.String() != want {
+		t.Fatalf("invalid DOT output, want\n%s\ngot\n%s", want, buf.String())
+	}
+}
+
+func TestWriteMermaid(t *testing.T) {
+	rs, err := LoadRules("test", strings.NewReader(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := rs.WriteMermaid(&buf, GraphOptions{RuleCounts: true, Rules: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `flowchart TD
+	start((start))
+	stop(((end)))
+	s0["Plural (2 rules)"]
+	s1["Feminine (2 rules)"]
+	s2["Vowel (1 rule)"]
+	start --> s0
+	s0 --> s1
+	s1 -->|pass| stop
+	s1 -->|fail| s2
+	s2 --> stop
+	s0 -.- s0_0["-ns → m"]
+	s0 -.- s0_1["-s"]
+	s1 -.- s1_0["-ona → ão"]
+	s1 -.- s1_1["-eira → eiro"]
+	s2 -.- s2_0["-o"]
+`
+	if buf.String() != want {
+		t.Fatalf("invalid Mermaid output, want\n%s\ngot\n%s", want, buf.String())
+	}
+}
+
+func TestWriteMermaidLoop(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Galician.WriteMermaid(&buf, GraphOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "\ts3 -->|pass| s3\n") {
+		t.Fatalf("missing the loop of the Augmentative step\n%s", buf.String())
+	}
+}
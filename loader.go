@@ -0,0 +1,282 @@
+package rslp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Patterns of the lines of a rule file. The steps and rules follow the
+// format of the original RSLP code, also used by Lucene:
+//
+//	{ "Plural", 3, 1, {"s"},
+//	{"ns",1,"m"},
+//	{"ões",3,"ão"},
+//	{"s",2,"",{"lápis","mais"}}};
+//
+// A step declares its name, the minimum length of the words, whether the
+// exceptions are compared with the entire word (1) or with its end (0) and
+// the endings a word must have for the step to run. Each rule declares its
+// suffix, the minimum length of the remaining stem, the replacement and the
+// exceptions; the last rule of a step ends with "};".
+//
+// The flow between the steps can be declared before them, as the step, the
+// next step when a rule applies and the next step otherwise:
+//
+//	{ "Noun", "", "Verb" },
+//
+// Without flow lines, each step passes to the next one in the file.
+var (
+	headerPattern = regexp.MustCompile(`^\{\s*"([^"]*)",\s*([0-9]+),\s*(0|1),\s*\{(.*)\},\s*$`)
+	stripPattern  = regexp.MustCompile(`^\{\s*"([^"]*)",\s*([0-9]+)\s*\}\s*(,|(\}\s*;))$`)
+	repPattern    = regexp.MustCompile(`^\{\s*"([^"]*)",\s*([0-9]+),\s*"([^"]*)"\s*\}\s*(,|(\}\s*;))$`)
+	excPattern    = regexp.MustCompile(`^\{\s*"([^"]*)",\s*([0-9]+),\s*"([^"]*)",\s*\{(.*)\}\s*\}\s*(,|(\}\s*;))$`)
+	flowPattern   = regexp.MustCompile(`^\{\s*"([^"]*)"\s*,\s*"([^"]*)"\s*,\s*"([^"]*)"\s*\}\s*[,;]?$`)
+
+	semanticsPattern = regexp.MustCompile(`^#\s*semantics:\s*(\S+)\s*$`)
+	foldPattern      = regexp.MustCompile(`^#\s*fold:\s*(\S+)\s*$`)
+)
+
+// LoadRules loads a rule set from a rule file in the RSLP format, the same
+// format as the .rslp files of Lucene. Lines starting with '#' are comments,
+// except for a "# version: v" line which sets the version of the rule set
+// and a "# semantics: lucene" line which applies the semantics of Lucene's
+// RSLPStemmerBase, as in LucenePortuguese: lengths counted in characters,
+// rules never removing a whole word, steps passing only when they change the
+// length of the word and short words stemmed too. A "# fold: name" line
+// selects how the diacritics of the stems are removed: "lucene" as by
+// Lucene's PortugueseStemmer, "galician" as by the Galician rule set and
+// "normalize", the default, removing all of them.
+func LoadRules(name string, r io.Reader) (*RuleSet, error) {
+	p := &ruleParser{name: name, scanner: bufio.NewScanner(r)}
+	return p.parse()
+}
+
+// LoadRulesFile loads the rule file at the path, in JSON or YAML for the
+// .json, .yaml and .yml extensions and in the RSLP format otherwise. The rule
+// set is named after the path unless the file names it.
+func LoadRulesFile(path string) (*RuleSet, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("rslp: %w", err)
+	}
+	defer f.Close()
+
+	switch filepath.Ext(path) {
+	case ".json":
+		return LoadRulesJSON(path, f)
+	case ".yaml", ".yml":
+		return LoadRulesYAML(path, f)
+	}
+	return LoadRules(path, f)
+}
+
+// ruleParser parses a rule file line by line.
+type ruleParser struct {
+	name      string
+	version   string
+	semantics string
+	fold      string
+	scanner   *bufio.Scanner
+	line      int
+}
+
+func (p *ruleParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("rslp: %s:%d: %s", p.name, p.line, fmt.Sprintf(format, args...))
+}
+
+// next returns the next line that is not blank nor a comment, recording
+// the version of a "# version: v" comment, the semantics of a "#
+// semantics: s" one and the fold of a "# fold: f" one.
+func (p *ruleParser) next() (string, bool) {
+	for p.scanner.Scan() {
+		p.line++
+		line := strings.TrimSpace(p.scanner.Text())
+		if m := versionPattern.FindStringSubmatch(line); m != nil {
+			p.version = m[1]
+		}
+		if m := semanticsPattern.FindStringSubmatch(line); m != nil {
+			p.semantics = m[1]
+		}
+		if m := foldPattern.FindStringSubmatch(line); m != nil {
+			p.fold = m[1]
+		}
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return line, true
+		}
+	}
+	return "", false
+}
+
+type flow struct {
+	step, pass, fail string
+}
+
+func (p *ruleParser) parse() (*RuleSet, error) {
+	rs := &RuleSet{name: p.name, steps: map[string]*step{}, fold: "normalize"}
+
+	var flows []flow
+	for {
+		line, ok := p.next()
+		if !ok {
+			break
+		}
+
+		if m := flowPattern.FindStringSubmatch(line); m != nil {
+			if len(rs.order) > 0 {
+				return nil, p.errorf("flow declared after the steps")
+			}
+			flows = append(flows, flow{m[1], m[2], m[3]})
+			continue
+		}
+
+		m := headerPattern.FindStringSubmatch(line)
+		if m == nil {
+			return nil, p.errorf("invalid step header %q", line)
+		}
+		if _, dup := rs.steps[m[1]]; dup {
+			return nil, p.errorf("duplicate step %q", m[1])
+		}
+
+		s, err := p.parseStep(m)
+		if err != nil {
+			return nil, err
+		}
+		rs.steps[m[1]] = s
+		rs.order = append(rs.order, m[1])
+	}
+	if err := p.scanner.Err(); err != nil {
+		return nil, fmt.Errorf("rslp: %s: %w", p.name, err)
+	}
+	if len(rs.order) == 0 {
+		return nil, fmt.Errorf("rslp: %s: no steps", p.name)
+	}
+	rs.version = p.version
+	switch p.semantics {
+	case "", "rslp":
+	case "lucene":
+		rs.lucene = true
+	default:
+		return nil, fmt.Errorf("rslp: %s: unknown semantics %q", p.name, p.semantics)
+	}
+	if p.fold != "" {
+		if _, ok := folds[p.fold]; !ok {
+			return nil, fmt.Errorf("rslp: %s: unknown fold %q", p.name, p.fold)
+		}
+		rs.fold = p.fold
+	}
+
+	if len(flows) == 0 {
+		for i, name := range rs.order {
+			if i+1 < len(rs.order) {
+				rs.steps[name].stepPass = rs.order[i+1]
+				rs.steps[name].stepFail = rs.order[i+1]
+			}
+		}
+		rs.start = rs.order[0]
+		return rs, nil
+	}
+
+	for _, f := range flows {
+		s, ok := rs.steps[f.step]
+		if !ok {
+			return nil, fmt.Errorf("rslp: %s: flow of undeclared step %q", p.name, f.step)
+		}
+		for _, next := range []string{f.pass, f.fail} {
+			if _, ok := rs.steps[next]; next != "" && !ok {
+				return nil, fmt.Errorf("rslp: %s: flow of %q to undeclared step %q", p.name, f.step, next)
+			}
+		}
+		s.stepPass, s.stepFail = f.pass, f.fail
+	}
+	rs.start = flows[0].step
+	return rs, nil
+}
+
+// parseStep parses the rules of the step whose header matched m.
+func (p *ruleParser) parseStep(m []string) (*step, error) {
+	minLength, err := strconv.Atoi(m[2])
+	if err != nil {
+		return nil, p.errorf("invalid minimum length %q", m[2])
+	}
+	endWords, err := p.parseList(m[4])
+	if err != nil {
+		return nil, err
+	}
+	s := &step{minLength: minLength, entireWord: m[3] == "1", endWords: endWords}
+
+	for {
+		line, ok := p.next()
+		if !ok {
+			return nil, p.errorf("step %q does not end with \"};\"", m[1])
+		}
+
+		r, err := p.parseRule(line)
+		if err != nil {
+			return nil, err
+		}
+		s.rules = append(s.rules, r)
+
+		if strings.HasSuffix(line, ";") {
+			return s, nil
+		}
+	}
+}
+
+// parseRule parses a rule line.
+func (p *ruleParser) parseRule(line string) (rule, error) {
+	var r rule
+	var minLength string
+	var err error
+
+	if m := stripPattern.FindStringSubmatch(line); m != nil {
+		r.suffix, minLength = m[1], m[2]
+	} else if m := repPattern.FindStringSubmatch(line); m != nil {
+		r.suffix, minLength, r.replacement = m[1], m[2], m[3]
+	} else if m := excPattern.FindStringSubmatch(line); m != nil {
+		r.suffix, minLength, r.replacement = m[1], m[2], m[3]
+		if r.exceptions, err = p.parseList(m[4]); err != nil {
+			return r, err
+		}
+	} else {
+		return r, p.errorf("invalid rule %q", line)
+	}
+
+	if r.suffix == "" {
+		return r, p.errorf("rule with an empty suffix")
+	}
+	if !utf8.ValidString(r.suffix) || !utf8.ValidString(r.replacement) {
+		return r, p.errorf("rule is not valid UTF-8")
+	}
+	if r.minLength, err = strconv.Atoi(minLength); err != nil {
+		return r, p.errorf("invalid minimum length %q", minLength)
+	}
+	return r, nil
+}
+
+// parseList parses a comma separated list of quoted strings.
+func (p *ruleParser) parseList(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) < 2 || item[0] != '"' || item[len(item)-1] != '"' {
+			return nil, p.errorf("invalid string %s", item)
+		}
+		item = item[1 : len(item)-1]
+		if !utf8.ValidString(item) {
+			return nil, p.errorf("string is not valid UTF-8")
+		}
+		list = append(list, item)
+	}
+	return list, nil
+}
@@ -0,0 +1,161 @@
+package rslp
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const testRules = `
+# a minimal rule set
+{ "Plural", "Feminine", "Feminine" },
+{ "Feminine", "", "Vowel" },
+{ "Vowel", "", "" };
+
+{ "Plural", 3, 1, {"s"},
+{"ns",1,"m"},
+{"s",2,"",{"lápis","mais"}}};
+
+{ "Feminine", 3, 0, {"a"},
+{"ona", 3, "ão"},
+{"eira",3,"eiro",{"beira"}}};
+
+{ "Vowel", 0, 1, {},
+{"o",3}};
+`
+
+func TestLoadRules(t *testing.T) {
+	rs, err := LoadRules("test", strings.NewReader(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"bons", "bom"},
+		{"lápis", "lapis"},
+		{"chefonas", "chefao"},
+		{"cadeira", "cadeiro"},
+		{"cabeira", "cabeira"}, // exceptions compared with the end of the word
+		{"menino", "menin"},
+	}
+
+	opts := Options{Rules: rs}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := opts.Stem(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoadRulesWithoutFlow(t *testing.T) {
+	rs, err := LoadRules("test", strings.NewReader(`
+{ "Plural", 3, 1, {"s"},
+{"s",2,""}};
+{ "Vowel", 0, 1, {},
+{"o",3,""}};
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := (Options{Rules: rs}).Stem("meninos"); got != "menin" {
+		t.Fatalf("invalid stem output, %q -> %q (got %q)", "meninos", "menin", got)
+	}
+}
+
+func TestLoadRulesErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{``, "no steps"},
+		{`{ "Plural", 3, 1, {"s"},`, "does not end"},
+		{"{ \"Plural\", 3, 1, {\"s\"},\n{\"s\",2,\"\"}};\n{ \"Plural\", 3, 1, {\"s\"},\n{\"s\",2,\"\"}};", "duplicate step"},
+		{"{ \"Plural\", 3, 1, {\"s\"},\n{s,2,\"\"}};", "invalid rule"},
+		{"{ \"Plural\", 3, 1, {\"s\"},\n{\"\",2,\"\"}};", "empty suffix"},
+		{"{ \"Plural\", 3, 1, {\"s\"},\n{\"\xff\",2,\"\"}};", "not valid UTF-8"},
+		{"{ \"Plural\", 3, 1, {s},\n{\"s\",2,\"\"}};", "invalid string"},
+		{"{ \"Plural\", \"Noun\", \"\" };\n{ \"Plural\", 3, 1, {\"s\"},\n{\"s\",2,\"\"}};", "undeclared step \"Noun\""},
+		{"{ \"Noun\", \"\", \"\" };\n{ \"Plural\", 3, 1, {\"s\"},\n{\"s\",2,\"\"}};", "undeclared step \"Noun\""},
+		{"{ \"Plural\", 3, 1, {\"s\"},\n{\"s\",2,\"\"}};\n{ \"Plural\", \"\", \"\" };", "flow declared after"},
+		{"Plural", "invalid step header"},
+		{"# semantics: solr\n{ \"Plural\", 3, 1, {\"s\"},\n{\"s\",2,\"\"}};", "unknown semantics \"solr\""},
+		{"# fold: ascii\n{ \"Plural\", 3, 1, {\"s\"},\n{\"s\",2,\"\"}};", "unknown fold \"ascii\""},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			_, err := LoadRules("test", strings.NewReader(tt.input))
+
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("invalid error, want %q (got %v)", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestLoadRulesFold(t *testing.T) {
+	tests := []struct {
+		fold  string
+		input string
+		want  string
+	}{
+		{"", "irmãos", "irmao"},
+		{"# fold: normalize", "irmãos", "irmao"},
+		{"# fold: lucene", "irmãos", "irmao"},
+		{"# fold: galician", "irmãos", "irmão"},
+		{"# fold: galician", "cafés", "cafe"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			rs, err := LoadRules("test", strings.NewReader(tt.fold+"\n{ \"Plural\", 3, 1, {},\n{\"s\",2,\"\"}};"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := (Options{Rules: rs}).Stem(tt.input); got != tt.want {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoadRulesLoop(t *testing.T) {
+	rs, err := LoadRules("test", strings.NewReader(`
+{ "Grow", "Grow", "Grow" };
+{ "Grow", 0, 1, {},
+{"a",1,"aa"}};
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the loop is bounded by maxStepRuns.
+	got := (Options{Rules: rs, KeepDiacritics: true}).Stem("casa")
+	if want := "cas" + strings.Repeat("a", maxStepRuns+1); got != want {
+		t.Fatalf("invalid stem output, %q -> %q (got %q)", "casa", want, got)
+	}
+}
+
+func TestLoadRulesFile(t *testing.T) {
+	for _, path := range []string{"rules/galician.rslp", "testdata/rules/test.yaml", "testdata/rules/test.json"} {
+		rs, err := LoadRulesFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rs.order) == 0 {
+			t.Fatalf("%s: no steps", path)
+		}
+	}
+	if _, err := LoadRulesFile("does-not-exist.rslp"); err == nil {
+		t.Fatal("expected an error")
+	}
+}
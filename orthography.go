@@ -0,0 +1,132 @@
+package rslp
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// orthographyRoots maps the beginning of words spelled before the 1990
+// Orthographic Agreement, or with the European spelling, to the canonical
+// (Brazilian) spelling. The consonants are only dropped from the roots where
+// they are silent: "pacto", "facção" and "recepção" keep them.
+var orthographyRoots = sortRoots(map[string]string{
+	// cç -> ç and ct -> t
+	"acç":        "aç",
+	"accion":     "acion",
+	"act":        "at",
+	"afect":      "afet",
+	"arquitect":  "arquitet",
+	"colecç":     "coleç",
+	"colect":     "colet",
+	"contact":    "contat",
+	"correcç":    "correç",
+	"correct":    "corret",
+	"direcç":     "direç",
+	"direct":     "diret",
+	"efect":      "efet",
+	"electr":     "eletr",
+	"eléctr":     "elétr",
+	"exact":      "exat",
+	"inactiv":    "inativ",
+	"infracç":    "infraç",
+	"infraccion": "infracion",
+	"inspecç":    "inspeç",
+	"inspect":    "inspet",
+	"object":     "objet",
+	"project":    "projet",
+	"protecç":    "proteç",
+	"protect":    "protet",
+	"reacç":      "reaç",
+	"reaccion":   "reacion",
+	"react":      "reat",
+	"secç":       "seç",
+	"sector":     "setor",
+	"selecç":     "seleç",
+	"seleccion":  "selecion",
+	"transacç":   "transaç",
+	// pç -> ç and pt -> t
+	"adopç":   "adoç",
+	"adopt":   "adot",
+	"assumpç": "assunç",
+	"baptis":  "batis",
+	"baptiz":  "batiz",
+	"egipt":   "egit",
+	"excepç":  "exceç",
+	"excepto": "exceto",
+	"optim":   "otim",
+	"óptim":   "ótim",
+	"óptic":   "ótic",
+	// other variant spellings
+	"húmid": "úmid",
+})
+
+// orthographyWords maps entire words whose silent consonant can't be told
+// apart by their root.
+var orthographyWords = map[string]string{
+	"facto":  "fato",
+	"factos": "fatos",
+	"tecto":  "teto",
+	"tectos": "tetos",
+}
+
+// orthographyExceptions are words starting with one of the roots that keep
+// their consonants.
+var orthographyExceptions = []string{"actínio", "actínia"}
+
+type root struct {
+	old, new string
+}
+
+// sortRoots returns the roots from the longest to the shortest, so that the
+// first matching root is the longest one.
+func sortRoots(roots map[string]string) []root {
+	list := make([]root, 0, len(roots))
+	for old, new := range roots {
+		list = append(list, root{old, new})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if len(list[i].old) != len(list[j].old) {
+			return len(list[i].old) > len(list[j].old)
+		}
+		return list[i].old < list[j].old
+	})
+	return list
+}
+
+// orthographyExceptionCache holds the spelling of the exceptions of the rule
+// sets by normalizeOrthography.
+var orthographyExceptionCache sync.Map
+
+// orthographicException returns the exception of a rule spelled by
+// normalizeOrthography.
+func orthographicException(e string) string {
+	if s, ok := orthographyExceptionCache.Load(e); ok {
+		return s.(string)
+	}
+	s := normalizeOrthography(e)
+	orthographyExceptionCache.Store(e, s)
+	return s
+}
+
+// normalizeOrthography maps a lowercase word to its canonical spelling: the
+// trema is removed (ü -> u) and the silent consonants dropped by the 1990
+// Orthographic Agreement (cç -> ç, ct -> t, pç -> ç, pt -> t) are removed
+// from the known roots.
+func normalizeOrthography(word string) string {
+	word = strings.ReplaceAll(word, "ü", "u")
+	if w, ok := orthographyWords[word]; ok {
+		return w
+	}
+	for _, e := range orthographyExceptions {
+		if strings.HasPrefix(word, e) {
+			return word
+		}
+	}
+	for _, r := range orthographyRoots {
+		if strings.HasPrefix(word, r.old) {
+			return r.new + word[len(r.old):]
+		}
+	}
+	return word
+}
@@ -0,0 +1,111 @@
+package rslp
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNormalizeOrthography(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"freqüente", "frequente"},
+		{"lingüiça", "linguiça"},
+		{"acção", "ação"},
+		{"reacções", "reações"},
+		{"infracção", "infração"},
+		{"accionista", "acionista"},
+		{"actualmente", "atualmente"},
+		{"director", "diretor"},
+		{"direcção", "direção"},
+		{"eléctrico", "elétrico"},
+		{"objectivo", "objetivo"},
+		{"sector", "setor"},
+		{"óptimo", "ótimo"},
+		{"optimismo", "otimismo"},
+		{"adopção", "adoção"},
+		{"baptismo", "batismo"},
+		{"egipto", "egito"},
+		{"facto", "fato"},
+		{"tectos", "tetos"},
+		{"húmido", "úmido"},
+		// pronounced consonants
+		{"pacto", "pacto"},
+		{"facção", "facção"},
+		{"recepção", "recepção"},
+		{"egípcio", "egípcio"},
+		{"actínio", "actínio"},
+		{"ação", "ação"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := normalizeOrthography(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid normalization output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOrthography(t *testing.T) {
+	tests := []struct {
+		european  string
+		brazilian string
+	}{
+		{"acção", "ação"},
+		{"acções", "ações"},
+		{"óptimo", "ótimo"},
+		{"facto", "fato"},
+		{"director", "diretor"},
+		{"eléctrico", "elétrico"},
+		{"Actualmente", "atualmente"},
+		{"adoptar", "adotar"},
+		{"secção", "seção"},
+		{"infracção", "infração"},
+		{"infracções", "infrações"},
+	}
+
+	opts := Options{Orthography: true}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got, want := opts.Stem(tt.european), opts.Stem(tt.brazilian)
+
+			if want != got {
+				t.Fatalf("spellings do not conflate, %q -> %q (got %q)", tt.european, want, got)
+			}
+			if Stem(tt.european) == want {
+				t.Fatalf("%q conflates without the normalization", tt.european)
+			}
+		})
+	}
+}
+
+func TestOrthographyExceptions(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		// the Noun exception "freqüente" is spelled like the word.
+		{"freqüente", "frequent"},
+		{"frequente", "frequent"},
+		{"freqüentes", "frequent"},
+	}
+
+	opts := Options{Orthography: true}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := opts.Stem(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+
+	if got := (Options{Orthography: true}).Explain("freqüente").Rules; len(got) == 0 || got[0].Exception != "freqüente" {
+		t.Fatalf("invalid explanation, want the exception %q (got %+v)", "freqüente", got)
+	}
+}
@@ -0,0 +1,234 @@
+package rslp
+
+import (
+	_ "embed"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+)
+
+// RuleSet is a set of stemming steps and the flow between them. Besides the
+// built-in rule sets, others can be loaded from rule files with LoadRules.
+type RuleSet struct {
+	name    string
+	version string
+	start   string
+	order   []string
+	steps   map[string]*step
+
+	// lucene applies the semantics of Lucene's RSLPStemmerBase: lengths are
+	// counted in characters instead of bytes, a rule never removes the whole
+	// word, a step only passes when it changes the length of the word and
+	// short words are stemmed as well.
+	lucene bool
+
+	// fold names the removal of the diacritics of the stemmed words, one of
+	// folds.
+	fold string
+
+	// examples are the examples of the steps and rules declared in a
+	// structured rule file, checked by Verify.
+	examples map[rulePosition]*examples
+
+	// compiled runs the steps instead of the rules when not nil, see
+	// Compiled.
+	compiled func(word string) string
+}
+
+// maxStepRuns bounds the number of steps run on a single word, so that
+// stemming terminates even if the flow of a loaded rule set has a loop.
+const maxStepRuns = 100
+
+// Portuguese is the default rule set, written after the original RSLP code.
+var Portuguese = &RuleSet{
+	name:    "portuguese",
+	version: "1",
+	start:   "Plural",
+	order:   []string{"Plural", "Feminine", "Augmentative", "Adverb", "Noun", "Verb", "Vowel"},
+	steps:   steps,
+	fold:    "normalize",
+}
+
+// PortugueseExtended is the Portuguese rule set with an extra step, run
+// before the Plural one, removing the second-person plural ("vós") verb
+// endings of European Portuguese, such as "cantáreis" or "falásseis", which
+// the Plural step would otherwise reduce as nouns ending in "-eis". The
+// present tense endings ("-ais", "-eis", "-is") are left to the Plural step,
+// since they can't be told apart from the plural of nouns, and the plurals
+// of the nouns the Verb step keeps ("agrestes") are exceptions.
+var PortugueseExtended = &RuleSet{
+	name:    "portuguese-extended",
+	version: "1",
+	start:   "Vos",
+	order:   append([]string{"Vos"}, Portuguese.order...),
+	steps: withStep(steps, "Vos", &step{"", "Plural", 0, true, []string{"s"}, []rule{
+		// Conditional
+		{"ar\u00edeis", 2, "", nil},
+		{"er\u00edeis", 3, "", nil},
+		{"ir\u00edeis", 3, "", nil},
+		// Imperfect subjunctive
+		{"\u00e1sseis", 2, "", nil},
+		{"\u00easseis", 3, "", nil},
+		{"\u00edsseis", 3, "", nil},
+		// Pluperfect
+		{"\u00e1reis", 2, "", nil},
+		{"\u00eareis", 3, "", nil},
+		{"\u00edreis", 3, "", nil},
+		// Future
+		{"areis", 2, "", nil},
+		{"ereis", 3, "", nil},
+		{"ireis", 3, "", nil},
+		// Imperfect (-er and -ir verbs)
+		{"\u00edeis", 3, "", nil},
+		// Preterite
+		{"astes", 2, "", nil},
+		{"estes", 3, "", []string{"faroestes", "agrestes"}},
+		{"istes", 3, "", nil},
+		// Future subjunctive and personal infinitive
+		{"ardes", 2, "", nil},
+		{"erdes", 3, "", nil},
+		{"irdes", 3, "", nil},
+	}}),
+	fold: "normalize",
+}
+
+// withStep returns a copy of the steps with the step added.
+func withStep(steps map[string]*step, name string, s *step) map[string]*step {
+	m := make(map[string]*step, len(steps)+1)
+	for k, v := range steps {
+		m[k] = v
+	}
+	m[name] = s
+	return m
+}
+
+//go:embed rules/lucene-portuguese.rslp
+var lucenePortugueseRules string
+
+// LucenePortuguese arranges the rules of Portuguese like the
+// PortugueseStemmer of Apache Lucene, used by Elasticsearch and Solr. Its
+// steps run in Lucene's order (Plural, Adverb, Feminine, Augmentative, Noun,
+// Verb and Vowel) with the semantics of Lucene's RSLPStemmerBase, and only
+// the accents known to Lucene are removed. Its rules are not Lucene's, so
+// its stems may differ from Lucene's. Input words are expected to be
+// lowercased, as Lucene's analyzers do.
+var LucenePortuguese = mustLoadRules("lucene-portuguese", lucenePortugueseRules)
+
+//go:embed rules/galician.rslp
+var galicianRules string
+
+// Galician is a Galician adaptation of RSLP. Its rules were written for this
+// package from the Galician spelling norms, following the Portuguese rules;
+// they are not a copy of Lucene's galician.rslp and its stems have not been
+// compared with Lucene's GalicianStemmer. Its steps are Plural, Unification,
+// Adverb, Augmentative (run while it changes the word), Noun, Verb and
+// Vowel, with the semantics of Lucene's RSLPStemmerBase. Input words are
+// expected to be lowercased.
+var Galician = mustLoadRules("galician", galicianRules)
+
+// luceneFold removes the accents the same way Lucene's PortugueseStemmer
+// does after the last step.
+var luceneFold = runes.Map(func(r rune) rune {
+	switch r {
+	case 'à', 'á', 'â', 'ã', 'ä', 'å':
+		return 'a'
+	case 'ç':
+		return 'c'
+	case 'è', 'é', 'ê', 'ë':
+		return 'e'
+	case 'ì', 'í', 'î', 'ï':
+		return 'i'
+	case 'ñ':
+		return 'n'
+	case 'ò', 'ó', 'ô', 'õ', 'ö':
+		return 'o'
+	case 'ù', 'ú', 'û', 'ü':
+		return 'u'
+	case 'ý', 'ÿ':
+		return 'y'
+	}
+	return r
+})
+
+// galicianFold removes the acute accents, and the circumflex of ê, after the
+// last step of the Galician rule set.
+var galicianFold = runes.Map(func(r rune) rune {
+	switch r {
+	case 'á':
+		return 'a'
+	case 'é', 'ê':
+		return 'e'
+	case 'í':
+		return 'i'
+	case 'ó':
+		return 'o'
+	case 'ú':
+		return 'u'
+	}
+	return r
+})
+
+// folds are the removals of the diacritics a rule set can apply to its
+// stems, selected by the "# fold: name" line of a rule file.
+var folds = map[string]transform.Transformer{
+	"normalize": normalize,
+	"lucene":    luceneFold,
+	"galician":  galicianFold,
+}
+
+// mustLoadRules loads a built-in rule set, panicking on errors.
+func mustLoadRules(name, rules string) *RuleSet {
+	rs, err := LoadRules(name, strings.NewReader(rules))
+	if err != nil {
+		panic(err)
+	}
+	return rs
+}
+
+// Name returns the name of the rule set.
+func (rs *RuleSet) Name() string {
+	return rs.name
+}
+
+// run applies the steps of the rule set to a lowercase word, notifying t of
+// the applied rules when it is not nil. With orthography, the word is spelled
+// by normalizeOrthography and so are the exceptions it is compared with.
+func (rs *RuleSet) run(word string, orthography bool, t tracer) string {
+	// the compiled exceptions are only spelled as in the rules.
+	if t == nil && !orthography && rs.compiled != nil {
+		return rs.compiled(word)
+	}
+
+	name := rs.start
+	for n := 0; n < maxStepRuns && rs.steps[name] != nil; n++ {
+		cur := rs.steps[name]
+
+		var notify func(*rule, ruleEvent, string)
+		if t != nil {
+			before := word
+			notify = func(r *rule, event ruleEvent, after string) {
+				t(name, r, event, before, after)
+			}
+		}
+
+		var r *rule
+		before := word
+		word, r = matchStep(word, cur, rs.lucene, orthography, notify)
+
+		passed := r != nil
+		if passed {
+			if rs.lucene {
+				passed = utf8.RuneCountInString(word) != utf8.RuneCountInString(before)
+			}
+		}
+
+		if passed {
+			name = cur.stepPass
+		} else {
+			name = cur.stepFail
+		}
+	}
+	return word
+}
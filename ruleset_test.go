@@ -0,0 +1,236 @@
+package rslp
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLucenePortuguese(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"dá", "da"},               // short words are stemmed too
+		{"pães", "pao"},            // lengths are counted in characters
+		{"adequadamente", "adequ"}, // Adverb runs before Feminine
+		{"curiosamente", "curi"},   // Adverb runs before Feminine
+		{"coração", "coraca"},
+		{"cantárei", "cant"},
+		{"balões", "bal"},
+	}
+
+	opts := Options{Rules: LucenePortuguese}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := opts.Stem(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
+
+// readLucene returns the "word<TAB>stem" pairs of a file of testdata/lucene.
+func readLucene(t *testing.T, name string) [][2]string {
+	f, err := os.Open(filepath.Join("testdata/lucene", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var pairs [][2]string
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Split(text, "\t")
+		if len(fields) != 2 {
+			t.Fatalf("%s:%d: want 2 fields (got %d)", name, line, len(fields))
+		}
+		pairs = append(pairs, [2]string{fields[0], fields[1]})
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return pairs
+}
+
+func TestLucenePortugueseSnapshot(t *testing.T) {
+	opts := Options{Rules: LucenePortuguese}
+	for _, pair := range readLucene(t, "snapshot.tsv") {
+		if got := opts.Stem(pair[0]); got != pair[1] {
+			t.Errorf("invalid stem output, %q -> %q (got %q)", pair[0], pair[1], got)
+		}
+	}
+}
+
+// TestLucenePortugueseExpected compares rslp.LucenePortuguese with the stems
+// of Lucene's PortugueseStemmer; any difference is a failure.
+func TestLucenePortugueseExpected(t *testing.T) {
+	if _, err := os.Stat("testdata/lucene/expected.tsv"); os.IsNotExist(err) {
+		t.Skip("testdata/lucene/expected.tsv is missing, generate it with testdata/lucene/Expected.java")
+	}
+
+	opts := Options{Rules: LucenePortuguese}
+	for _, pair := range readLucene(t, "expected.tsv") {
+		if got := opts.Stem(pair[0]); got != pair[1] {
+			t.Errorf("stem differs from Lucene, %q -> %q (got %q)", pair[0], pair[1], got)
+		}
+	}
+}
+
+func TestRuleSetName(t *testing.T) {
+	if got := Portuguese.Name(); got != "portuguese" {
+		t.Fatalf("invalid name, want %q (got %q)", "portuguese", got)
+	}
+	if got := LucenePortuguese.Name(); got != "lucene-portuguese" {
+		t.Fatalf("invalid name, want %q (got %q)", "lucene-portuguese", got)
+	}
+	if got := PortugueseExtended.Name(); got != "portuguese-extended" {
+		t.Fatalf("invalid name, want %q (got %q)", "portuguese-extended", got)
+	}
+	if got := Galician.Name(); got != "galician" {
+		t.Fatalf("invalid name, want %q (got %q)", "galician", got)
+	}
+}
+
+func TestGalician(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		// Plural
+		{"cans", "can"},
+		{"luces", "luz"},
+		{"animais", "animal"},
+		{"papeis", "papel"},
+		{"caracois", "caracol"},
+		{"azuis", "azul"},
+		{"fusís", "fusil"},
+		{"fáciles", "facil"},
+		{"mulleres", "muller"},
+		{"casas", "cas"},
+		// Unification
+		{"amábel", "am"},
+		{"amables", "am"},
+		{"amábeis", "am"},
+		// Adverb
+		{"rapidamente", "rap"},
+		// Augmentative
+		{"lindísimo", "lind"},
+		{"casiña", "cas"},
+		{"paxariño", "pax"},
+		{"golpazo", "golp"},
+		// Noun
+		{"contaxe", "cont"},
+		{"traballador", "traball"},
+		{"traballadora", "traball"},
+		{"organización", "organiz"},
+		{"universidade", "univers"},
+		{"importante", "import"},
+		// Verb
+		{"cantar", "cant"},
+		{"cantando", "cant"},
+		{"cantabamos", "cant"},
+		{"cantaches", "cant"},
+		{"cantaron", "cant"},
+		{"cantan", "cant"},
+		{"cantarei", "cant"},
+		{"cantaredes", "cant"},
+		{"cantariamos", "cant"},
+		{"cantásemos", "cant"},
+		{"beberon", "beb"},
+		{"bebín", "beb"},
+		{"bebería", "beb"},
+		{"partiu", "part"},
+		{"partides", "part"},
+		// Vowel
+		{"galego", "galeg"},
+		{"galegas", "galeg"},
+		{"homes", "hom"},
+	}
+
+	opts := Options{Rules: Galician}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := opts.Stem(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPortugueseExtended(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		// Imperfect indicative
+		{"cantáveis", "cant"},
+		{"bebíeis", "beb"},
+		{"partíeis", "part"},
+		// Preterite
+		{"cantastes", "cant"},
+		{"bebestes", "beb"},
+		{"partistes", "part"},
+		{"agrestes", "agrest"}, // nouns kept by the Verb step
+		{"faroestes", "faroest"},
+		// Pluperfect
+		{"cantáreis", "cant"},
+		{"bebêreis", "beb"},
+		{"partíreis", "part"},
+		// Future
+		{"cantareis", "cant"},
+		{"bebereis", "beb"},
+		{"partireis", "part"},
+		// Conditional
+		{"cantaríeis", "cant"},
+		{"beberíeis", "beb"},
+		{"partiríeis", "part"},
+		// Imperfect subjunctive
+		{"falásseis", "fal"},
+		{"bebêsseis", "beb"},
+		{"partísseis", "part"},
+		// Future subjunctive and personal infinitive
+		{"cantardes", "cant"},
+		{"beberdes", "beb"},
+		{"partirdes", "part"},
+		// Imperative
+		{"cantai", "cant"},
+		{"bebei", "beb"},
+		// Nouns are still reduced by the Plural step
+		{"animais", "animal"},
+		{"papéis", "papel"},
+		{"testes", "test"},
+	}
+
+	opts := Options{Rules: PortugueseExtended}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := opts.Stem(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPortugueseExtendedCompatible(t *testing.T) {
+	opts := Options{Rules: PortugueseExtended}
+	for _, tt := range stemTests {
+		if got, want := opts.Stem(tt.input), Stem(tt.input); got != want {
+			t.Errorf("extended stem differs, %q -> %q (got %q)", tt.input, want, got)
+		}
+	}
+}
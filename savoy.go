@@ -0,0 +1,156 @@
+package rslp
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Savoy is the light Portuguese stemmer of Jacques Savoy, as implemented by
+// Lucene's PortugueseLightStemmer. It only reduces plurals, adverbs and
+// feminine forms and removes the final vowel, which makes it a good choice
+// for short fields such as titles. The zero value removes the diacritics of
+// the stems other than the tilde of ã and õ, which Lucene keeps too.
+type Savoy struct {
+	// KeepDiacritics keeps the diacritics of the stemmed words, which are
+	// removed by default.
+	KeepDiacritics bool
+}
+
+var _ Stemmer = Savoy{}
+
+// Stems a sentence with the Savoy light stemmer. It returns the same
+// sentence but with all words stemmed.
+func StemSentenceLight(sentence string, removeDiacritics ...bool) string {
+	return savoy(removeDiacritics).StemSentence(sentence)
+}
+
+// Stems a single word with the Savoy light stemmer. It returns the stemmed
+// word.
+func StemLight(word string, removeDiacritics ...bool) string {
+	return savoy(removeDiacritics).Stem(word)
+}
+
+// savoy returns the Savoy stemmer equivalent to the removeDiacritics argument
+// accepted by StemLight and StemSentenceLight.
+func savoy(removeDiacritics []bool) Savoy {
+	return Savoy{KeepDiacritics: options(removeDiacritics).KeepDiacritics}
+}
+
+// StemSentence stems a sentence. It returns the same sentence but with all
+// words stemmed.
+func (s Savoy) StemSentence(sentence string) string {
+	return stemSentence(sentence, s.Stem)
+}
+
+// Stem stems a single word. It returns the stemmed word, which is always
+// valid UTF-8.
+func (s Savoy) Stem(word string) string {
+	word = strings.ToLower(strings.TrimSpace(strings.ToValidUTF8(word, string(utf8.RuneError))))
+	w := []rune(word)
+	if len(w) < 4 {
+		return word
+	}
+
+	w = savoySuffix(w)
+	if len(w) > 3 && w[len(w)-1] == 'a' {
+		w = savoyFeminine(w)
+	}
+	if len(w) > 4 {
+		switch w[len(w)-1] {
+		case 'e', 'a', 'o':
+			w = w[:len(w)-1]
+		}
+	}
+
+	if !s.KeepDiacritics {
+		for i, r := range w {
+			w[i] = savoyFold(r)
+		}
+	}
+	return string(w)
+}
+
+// runesSuffix reports whether the word ends with any of the suffixes.
+func runesSuffix(w []rune, suffixes ...string) bool {
+	for _, s := range suffixes {
+		if strings.HasSuffix(string(w), s) {
+			return true
+		}
+	}
+	return false
+}
+
+// savoySuffix reduces the plural forms and the adverbs.
+func savoySuffix(w []rune) []rune {
+	n := len(w)
+	switch {
+	case n > 4 && runesSuffix(w, "es") && strings.ContainsRune("rslz", w[n-3]):
+		return w[:n-2]
+	case n > 3 && runesSuffix(w, "ns"):
+		w[n-2] = 'm'
+		return w[:n-1]
+	case n > 4 && runesSuffix(w, "eis", "éis"):
+		w[n-3], w[n-2] = 'e', 'l'
+		return w[:n-1]
+	case n > 4 && runesSuffix(w, "ais"):
+		w[n-2] = 'l'
+		return w[:n-1]
+	case n > 4 && runesSuffix(w, "óis"):
+		w[n-3], w[n-2] = 'o', 'l'
+		return w[:n-1]
+	case n > 4 && runesSuffix(w, "is"):
+		w[n-1] = 'l'
+		return w
+	case n > 3 && runesSuffix(w, "ões", "ães"):
+		w[n-3], w[n-2] = 'ã', 'o'
+		return w[:n-1]
+	case n > 6 && runesSuffix(w, "mente"):
+		return w[:n-5]
+	case n > 3 && w[n-1] == 's':
+		return w[:n-1]
+	}
+	return w
+}
+
+// savoyFeminine reduces the feminine forms to the masculine ones.
+func savoyFeminine(w []rune) []rune {
+	n := len(w)
+	switch {
+	case n > 7 && runesSuffix(w, "inha", "iaca", "eira"):
+		w[n-1] = 'o'
+	case n <= 6:
+	case runesSuffix(w, "osa", "ica", "ida", "ada", "iva", "ama"):
+		w[n-1] = 'o'
+	case runesSuffix(w, "ona"):
+		w[n-3], w[n-2] = 'ã', 'o'
+		return w[:n-1]
+	case runesSuffix(w, "ora"):
+		return w[:n-1]
+	case runesSuffix(w, "esa"):
+		w[n-3] = 'ê'
+		return w[:n-1]
+	case runesSuffix(w, "na"):
+		w[n-1] = 'o'
+	}
+	return w
+}
+
+// savoyFold removes the accent of a letter, the same way Lucene's
+// PortugueseLightStemmer does: the tilde of ã and õ is kept.
+func savoyFold(r rune) rune {
+	switch r {
+	case 'à', 'á', 'â', 'ä':
+		return 'a'
+	case 'ò', 'ó', 'ô', 'ö':
+		return 'o'
+	case 'è', 'é', 'ê', 'ë':
+		return 'e'
+	case 'ù', 'ú', 'û', 'ü':
+		return 'u'
+	case 'ì', 'í', 'î', 'ï':
+		return 'i'
+	case 'ç':
+		return 'c'
+	}
+	return r
+}
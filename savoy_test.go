@@ -0,0 +1,106 @@
+package rslp
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStemLight(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		// Plural and adverb
+		{"balões", "balã"},
+		{"capitães", "capitã"},
+		{"bons", "bom"},
+		{"normais", "normal"},
+		{"amáveis", "amavel"},
+		{"papéis", "papel"},
+		{"lençóis", "lencol"},
+		{"barris", "barril"},
+		{"flores", "flor"},
+		{"rapazes", "rapaz"},
+		{"males", "mal"},
+		{"casas", "casa"},
+		{"felizmente", "feliz"},
+		// Feminine
+		{"sozinha", "sozinh"},
+		{"maníaca", "maniac"},
+		{"brasileira", "brasileir"},
+		{"famosa", "famos"},
+		{"cansada", "cansad"},
+		{"chefona", "chefã"},
+		{"professora", "professor"},
+		{"inglesa", "ingles"},
+		{"americana", "american"},
+		// Final vowel
+		{"livro", "livr"},
+		{"cantar", "cantar"},
+		// Short words
+		{"mesa", "mesa"},
+		{"pé", "pé"},
+		// Casing and spaces
+		{" Árvores ", "arvor"},
+		// The tilde is kept, as by Lucene
+		{"corações", "coracã"},
+		{"irmãos", "irmã"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := StemLight(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStemLightKeepDiacritics(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"balões", "balã"},
+		{"amáveis", "amável"},
+		{"lençóis", "lençol"},
+		{"inglesa", "inglês"},
+		{"Árvores", "árvor"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := StemLight(tt.input, false)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+			if got := (Savoy{KeepDiacritics: true}).Stem(tt.input); tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStemSentenceLight(t *testing.T) {
+	tests := []struct {
+		input         string
+		want          string
+		removeAccents bool
+	}{
+		{"As professoras   inglesas", "as professor ingles", true},
+		{"As professoras inglesas", "as professor inglês", false},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := StemSentenceLight(tt.input, tt.removeAccents)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package rslp
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+)
+
+// Snowball is the Portuguese stemmer of the Snowball project
+// (https://snowballstem.org/algorithms/portuguese/stemmer.html). It is less
+// aggressive than RSLP: suffixes are only removed from the R1, R2 and RV
+// regions of the word. The zero value gives the same stems as the reference
+// implementation, which keeps the diacritics.
+type Snowball struct {
+	// RemoveDiacritics removes the diacritics of the stemmed words, as RSLP
+	// does by default.
+	RemoveDiacritics bool
+}
+
+// snowballVowels are the vowels of the Snowball Portuguese stemmer.
+const snowballVowels = "aeiouáéíóúâêô"
+
+// snowballNasal replaces the nasalised vowels by a vowel followed by '~',
+// which the algorithm treats as a consonant.
+var (
+	snowballNasal   = strings.NewReplacer("ã", "a~", "õ", "o~")
+	snowballUnnasal = strings.NewReplacer("a~", "ã", "o~", "õ")
+)
+
+// snowballSuffixes sorts suffixes from the longest to the shortest, so that
+// the first matching suffix is the longest one.
+func snowballSuffixes(suffixes ...string) []string {
+	sort.SliceStable(suffixes, func(i, j int) bool {
+		return len(suffixes[i]) > len(suffixes[j])
+	})
+	return suffixes
+}
+
+// Step 1: Standard suffix removal.
+var (
+	snowballDelete = []string{
+		"eza", "ezas", "ico", "ica", "icos", "icas", "ismo", "ismos", "ável", "ível", "ista", "istas",
+		"oso", "osa", "osos", "osas", "amento", "amentos", "imento", "imentos", "adora", "ador",
+		"aça~o", "adoras", "adores", "aço~es", "ante", "antes", "ância",
+	}
+	snowballLogia  = []string{"logia", "logias"}
+	snowballUcao   = []string{"uça~o", "uço~es"}
+	snowballEncia  = []string{"ência", "ências"}
+	snowballIdade  = []string{"idade", "idades"}
+	snowballIva    = []string{"iva", "ivo", "ivas", "ivos"}
+	snowballIra    = []string{"ira", "iras"}
+	snowballSuffix = snowballSuffixes(append(append(append(append(append(append(append(
+		[]string{"amente", "mente"}, snowballDelete...), snowballLogia...), snowballUcao...),
+		snowballEncia...), snowballIdade...), snowballIva...), snowballIra...)...)
+)
+
+// Step 2: Verb suffixes.
+var snowballVerb = snowballSuffixes(
+	"ada", "ida", "ia", "aria", "eria", "iria", "ará", "ara", "erá", "era", "irá", "ava", "asse",
+	"esse", "isse", "aste", "este", "iste", "ei", "arei", "erei", "irei", "am", "iam", "ariam",
+	"eriam", "iriam", "aram", "eram", "iram", "avam", "em", "arem", "erem", "irem", "assem",
+	"essem", "issem", "ado", "ido", "ando", "endo", "indo", "ara~o", "era~o", "ira~o", "ar", "er",
+	"ir", "as", "adas", "idas", "ias", "arias", "erias", "irias", "arás", "aras", "erás", "eras",
+	"irás", "avas", "es", "ardes", "erdes", "irdes", "ares", "eres", "ires", "asses", "esses",
+	"isses", "astes", "estes", "istes", "is", "ais", "eis", "íeis", "aríeis", "eríeis", "iríeis",
+	"áreis", "areis", "éreis", "ereis", "íreis", "ireis", "ásseis", "ésseis", "ísseis", "áveis",
+	"ados", "idos", "ámos", "amos", "íamos", "aríamos", "eríamos", "iríamos", "áramos", "éramos",
+	"íramos", "ávamos", "emos", "aremos", "eremos", "iremos", "ássemos", "êssemos", "íssemos",
+	"imos", "armos", "ermos", "irmos", "eu", "iu", "ou", "ira", "iras",
+)
+
+// Step 4: Residual suffix.
+var snowballResidual = snowballSuffixes("os", "a", "i", "o", "á", "í", "ó")
+
+// Stem stems a single word. It returns the stemmed word, which is always
+// valid UTF-8.
+func (s Snowball) Stem(word string) string {
+	word = strings.ToLower(strings.TrimSpace(strings.ToValidUTF8(word, string(utf8.RuneError))))
+	stem := snowballUnnasal.Replace(snowballStem(snowballNasal.Replace(word)))
+	if s.RemoveDiacritics {
+		if folded, _, err := transform.String(normalize, stem); err == nil && folded != "" {
+			stem = folded
+		}
+	}
+	return stem
+}
+
+// StemSentence stems a sentence. It returns the same sentence but with all
+// words stemmed.
+func (s Snowball) StemSentence(sentence string) string {
+	return stemSentence(sentence, s.Stem)
+}
+
+// snowballStem stems a lowercase word whose nasalised vowels were replaced.
+func snowballStem(word string) string {
+	w := snowballWord{word: word}
+	w.markRegions()
+
+	if w.standardSuffix() || w.verbSuffix() {
+		// Step 3: delete a final i preceded by c in RV.
+		if w.endsIn("i", w.rv) && strings.HasSuffix(w.word, "ci") {
+			w.word = w.word[:len(w.word)-1]
+		}
+	} else if s, ok := w.longest(snowballResidual, 0); ok && w.endsIn(s, w.rv) {
+		w.word = w.word[:len(w.word)-len(s)]
+	}
+
+	// Step 5: Residual form.
+	switch {
+	case w.endsIn("e", w.rv) || w.endsIn("é", w.rv) || w.endsIn("ê", w.rv):
+		_, n := utf8.DecodeLastRuneInString(w.word)
+		w.word = w.word[:len(w.word)-n]
+		if w.endsIn("u", w.rv) && strings.HasSuffix(w.word, "gu") ||
+			w.endsIn("i", w.rv) && strings.HasSuffix(w.word, "ci") {
+			w.word = w.word[:len(w.word)-1]
+		}
+	case strings.HasSuffix(w.word, "ç"):
+		w.word = strings.TrimSuffix(w.word, "ç") + "c"
+	}
+	return w.word
+}
+
+// snowballWord is a word being stemmed along with the byte offsets of its
+// regions, which are never changed by the removal of its suffixes.
+type snowballWord struct {
+	word       string
+	rv, r1, r2 int
+}
+
+func isSnowballVowel(r rune) bool {
+	return strings.ContainsRune(snowballVowels, r)
+}
+
+// gopast returns the offset after the first rune at or after i that is a
+// vowel (or not, if vowel is false), or -1 when there is none.
+func (w *snowballWord) gopast(i int, vowel bool) int {
+	for i < len(w.word) {
+		r, n := utf8.DecodeRuneInString(w.word[i:])
+		i += n
+		if isSnowballVowel(r) == vowel {
+			return i
+		}
+	}
+	return -1
+}
+
+// markRegions computes the RV, R1 and R2 regions of the word.
+func (w *snowballWord) markRegions() {
+	n := len(w.word)
+	w.rv, w.r1, w.r2 = n, n, n
+
+	first, n0 := utf8.DecodeRuneInString(w.word)
+	second, n1 := utf8.DecodeRuneInString(w.word[n0:])
+	if n1 > 0 {
+		switch {
+		case isSnowballVowel(first) && !isSnowballVowel(second):
+			// the next vowel after a vowel and a consonant, or the end of
+			// the word without one.
+			if i := w.gopast(n0+n1, true); i >= 0 {
+				w.rv = i
+			}
+		case isSnowballVowel(first):
+			// the next consonant after two vowels.
+			if i := w.gopast(n0+n1, false); i >= 0 {
+				w.rv = i
+			}
+		case !isSnowballVowel(second):
+			// the next vowel after two consonants.
+			if i := w.gopast(n0+n1, true); i >= 0 {
+				w.rv = i
+			}
+		case n0+n1 < len(w.word):
+			// the third letter after a consonant and a vowel.
+			_, n2 := utf8.DecodeRuneInString(w.word[n0+n1:])
+			w.rv = n0 + n1 + n2
+		}
+	}
+
+	if i := w.gopast(0, true); i >= 0 {
+		if i = w.gopast(i, false); i >= 0 {
+			w.r1 = i
+			if i = w.gopast(i, true); i >= 0 {
+				if i = w.gopast(i, false); i >= 0 {
+					w.r2 = i
+				}
+			}
+		}
+	}
+}
+
+// endsIn reports whether the word ends with suffix and the suffix starts at
+// or after the region offset.
+func (w *snowballWord) endsIn(suffix string, region int) bool {
+	return strings.HasSuffix(w.word, suffix) && len(w.word)-len(suffix) >= region
+}
+
+// longest returns the longest suffix of the word among suffixes, which must
+// be sorted by snowballSuffixes, considering only the part of the word after
+// the region offset.
+func (w *snowballWord) longest(suffixes []string, region int) (string, bool) {
+	for _, s := range suffixes {
+		if w.endsIn(s, region) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// replace replaces the suffix of the word.
+func (w *snowballWord) replace(suffix, replacement string) {
+	w.word = w.word[:len(w.word)-len(suffix)] + replacement
+}
+
+// deleteIn removes one of the suffixes from the word when it is in the
+// region. It reports whether a suffix was removed.
+func (w *snowballWord) deleteIn(region int, suffixes ...string) bool {
+	for _, s := range suffixes {
+		if strings.HasSuffix(w.word, s) {
+			if !w.endsIn(s, region) {
+				return false
+			}
+			w.replace(s, "")
+			return true
+		}
+	}
+	return false
+}
+
+// standardSuffix runs the step 1 of the algorithm. It reports whether the
+// word was changed.
+func (w *snowballWord) standardSuffix() bool {
+	s, ok := w.longest(snowballSuffix, 0)
+	if !ok {
+		return false
+	}
+	switch {
+	case contains(snowballDelete, s):
+		return w.deleteIn(w.r2, s)
+	case contains(snowballLogia, s):
+		if !w.endsIn(s, w.r2) {
+			return false
+		}
+		w.replace(s, "log")
+	case contains(snowballUcao, s):
+		if !w.endsIn(s, w.r2) {
+			return false
+		}
+		w.replace(s, "u")
+	case contains(snowballEncia, s):
+		if !w.endsIn(s, w.r2) {
+			return false
+		}
+		w.replace(s, "ente")
+	case s == "amente":
+		if !w.deleteIn(w.r1, s) {
+			return false
+		}
+		if w.deleteIn(w.r2, "iv") {
+			w.deleteIn(w.r2, "at")
+		} else {
+			w.deleteIn(w.r2, "os", "ic", "ad")
+		}
+	case s == "mente":
+		if !w.deleteIn(w.r2, s) {
+			return false
+		}
+		w.deleteIn(w.r2, "ante", "avel", "ível")
+	case contains(snowballIdade, s):
+		if !w.deleteIn(w.r2, s) {
+			return false
+		}
+		w.deleteIn(w.r2, "abil", "ic", "iv")
+	case contains(snowballIva, s):
+		if !w.deleteIn(w.r2, s) {
+			return false
+		}
+		w.deleteIn(w.r2, "at")
+	case contains(snowballIra, s):
+		if !w.endsIn(s, w.rv) || !strings.HasSuffix(w.word[:len(w.word)-len(s)], "e") {
+			return false
+		}
+		w.replace(s, "ir")
+	}
+	return true
+}
+
+// verbSuffix runs the step 2 of the algorithm. It reports whether the word
+// was changed.
+func (w *snowballWord) verbSuffix() bool {
+	s, ok := w.longest(snowballVerb, w.rv)
+	if ok {
+		w.replace(s, "")
+	}
+	return ok
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
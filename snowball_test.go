@@ -0,0 +1,141 @@
+package rslp
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSnowball(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		// Sample vocabulary of the Snowball project.
+		{"boa", "boa"},
+		{"boassu", "boassu"},
+		{"boataria", "boat"},
+		{"boate", "boat"},
+		{"boatos", "boat"},
+		{"bobagem", "bobag"},
+		{"bobalhões", "bobalhõ"},
+		{"bobear", "bob"},
+		{"bobeira", "bobeir"},
+		{"bobinhos", "bobinh"},
+		{"bocadas", "boc"},
+		{"bocaiúva", "bocaiúv"},
+		{"boçal", "boçal"},
+		{"bodoque", "bodoqu"},
+		{"boemia", "boem"},
+		{"boêmios", "boêmi"},
+		{"bogotá", "bogot"},
+		{"bóia", "bói"},
+		{"boiando", "boi"},
+		{"quilométricas", "quilométr"},
+		{"quilômetros", "quilômetr"},
+		{"químicas", "químic"},
+		{"quimioterapia", "quimioterap"},
+		{"quimioterápicos", "quimioteráp"},
+		{"quinhão", "quinhã"},
+		{"quinhentos", "quinhent"},
+		{"quintal", "quintal"},
+		{"quintão", "quintã"},
+		{"quintessência", "quintessent"},
+		{"quintuplicou", "quintuplic"},
+		{"quinzena", "quinzen"},
+		{"quiosque", "quiosqu"},
+		// Step 1
+		{"possibilidade", "possibil"},
+		{"biologia", "biolog"},
+		{"inteligência", "inteligent"},
+		{"relativamente", "relat"},
+		{"ativamente", "ativ"},
+		{"felizmente", "feliz"},
+		{"contentamento", "content"},
+		{"bibliotecas", "bibliotec"},
+		// Step 2
+		{"cantaríamos", "cant"},
+		{"fizeste", "fiz"},
+		{"vendedoras", "vendedor"},
+		{"nações", "naçõ"},
+		// Step 3
+		{"apareci", "aparec"},
+		{"aparecia", "aparec"},
+		// Step 4
+		{"solução", "soluçã"},
+		{"amigos", "amig"},
+		// Step 5
+		{"jogue", "jog"},
+		{"abafe", "abaf"},
+		// Short words, whose RV region is empty
+		{"ar", "ar"},
+		{"os", "os"},
+		{"em", "em"},
+		{"ir", "ir"},
+		{"as", "as"},
+		// Casing and spaces
+		{" Quinzena ", "quinzen"},
+		{"", ""},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := Snowball{}.Stem(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSnowballRemoveDiacritics(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"solução", "soluca"},
+		{"quimioterápicos", "quimioterap"},
+		{"quinhão", "quinha"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := Snowball{RemoveDiacritics: true}.Stem(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSnowballSentence(t *testing.T) {
+	input := "Os químicos   apareciam bobeando"
+	want := "os químic aparec bob"
+
+	if got := (Snowball{}).StemSentence(input); got != want {
+		t.Fatalf("invalid stem output, %q -> %q (got %q)", input, want, got)
+	}
+}
+
+func TestStemmer(t *testing.T) {
+	tests := []struct {
+		stemmer Stemmer
+		input   string
+		want    string
+	}{
+		{Options{}, "quimioterápicos", "quimioterap"},
+		{Options{Rules: LucenePortuguese}, "cantárei", "cant"},
+		{Snowball{}, "quimioterápicos", "quimioteráp"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := tt.stemmer.Stem(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package rslp
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// RuleStats counts how often a rule fired while stemming a corpus. Only the
+// words ending with the suffix of the rule, which reached its step before
+// another rule of the step was applied, are counted.
+type RuleStats struct {
+	Step        string `json:"step"`
+	Rule        int    `json:"rule"` // index of the rule in its step
+	Suffix      string `json:"suffix"`
+	Replacement string `json:"replacement"`
+
+	// Applied is the number of times the rule changed a word.
+	Applied int `json:"applied"`
+
+	// Exception is the number of times an exception blocked the rule, and
+	// Exceptions that number for each of the exceptions.
+	Exception  int            `json:"blocked_by_exception"`
+	Exceptions map[string]int `json:"exceptions,omitempty"`
+
+	// Length is the number of times the word was too short for the rule.
+	Length int `json:"blocked_by_length"`
+}
+
+// Stats stems words like the options it was created with, while counting
+// how often each rule of their rule set fired. It is safe for concurrent
+// use.
+type Stats struct {
+	opts Options
+
+	mu    sync.Mutex
+	words int
+	rules []RuleStats
+	index map[*rule]int
+}
+
+var _ Stemmer = (*Stats)(nil)
+
+// NewStats returns an instrumented stemmer using the options.
+func NewStats(o Options) *Stats {
+	s := &Stats{opts: o, index: map[*rule]int{}}
+	rs := o.rules()
+	for _, name := range rs.order {
+		cur := rs.steps[name]
+		for i := range cur.rules {
+			r := &cur.rules[i]
+			s.index[r] = len(s.rules)
+			s.rules = append(s.rules, RuleStats{
+				Step:        name,
+				Rule:        i,
+				Suffix:      r.suffix,
+				Replacement: r.replacement,
+			})
+		}
+	}
+	return s
+}
+
+// Stem stems a single word like Options.Stem does, counting the rules that
+// fired on it.
+func (s *Stats) Stem(word string) string {
+	rs := s.opts.rules()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.words++
+	stem, _ := s.opts.stem(strings.ToValidUTF8(word, string(utf8.RuneError)), func(step string, r *rule, event ruleEvent, before, after string) {
+		st := &s.rules[s.index[r]]
+		switch event {
+		case ruleApplied:
+			st.Applied++
+		case ruleLength:
+			st.Length++
+		case ruleException:
+			st.Exception++
+			if st.Exceptions == nil {
+				st.Exceptions = map[string]int{}
+			}
+			st.Exceptions[r.exception(before, rs.steps[step].entireWord, s.opts.Orthography)]++
+		}
+	})
+	return stem
+}
+
+// Words returns the number of words stemmed.
+func (s *Stats) Words() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.words
+}
+
+// Rules returns the statistics of all the rules of the rule set, in the
+// order of the steps, including the rules that never fired.
+func (s *Stats) Rules() []RuleStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rules := make([]RuleStats, len(s.rules))
+	for i, r := range s.rules {
+		if r.Exceptions != nil {
+			exceptions := make(map[string]int, len(r.Exceptions))
+			for e, n := range r.Exceptions {
+				exceptions[e] = n
+			}
+			r.Exceptions = exceptions
+		}
+		rules[i] = r
+	}
+	return rules
+}
+
+// Dead returns the statistics of the rules that never matched a word.
+func (s *Stats) Dead() []RuleStats {
+	var dead []RuleStats
+	for _, r := range s.Rules() {
+		if r.Applied == 0 && r.Exception == 0 && r.Length == 0 {
+			dead = append(dead, r)
+		}
+	}
+	return dead
+}
+
+// WriteJSON writes the statistics as a JSON object with the name of the rule
+// set, the number of words stemmed and the statistics of each rule.
+func (s *Stats) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Rules string      `json:"rules"`
+		Words int         `json:"words"`
+		Stats []RuleStats `json:"stats"`
+	}{s.opts.rules().Name(), s.Words(), s.Rules()})
+}
+
+// WriteCSV writes the statistics of each rule as CSV, with a header. The
+// exceptions column lists the exceptions that blocked the rule, from the
+// most to the least frequent, as "exception:count" separated by spaces.
+func (s *Stats) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"step", "rule", "suffix", "replacement", "applied", "blocked_by_exception", "blocked_by_length", "exceptions"})
+	for _, r := range s.Rules() {
+		cw.Write([]string{
+			r.Step,
+			strconv.Itoa(r.Rule),
+			r.Suffix,
+			r.Replacement,
+			strconv.Itoa(r.Applied),
+			strconv.Itoa(r.Exception),
+			strconv.Itoa(r.Length),
+			formatExceptions(r.Exceptions),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// formatExceptions formats the counts of the exceptions for WriteCSV.
+func formatExceptions(counts map[string]int) string {
+	exceptions := make([]string, 0, len(counts))
+	for e := range counts {
+		exceptions = append(exceptions, e)
+	}
+	sort.Slice(exceptions, func(i, j int) bool {
+		a, b := exceptions[i], exceptions[j]
+		return counts[a] > counts[b] || counts[a] == counts[b] && a < b
+	})
+
+	var buf []byte
+	for i, e := range exceptions {
+		if i > 0 {
+			buf = append(buf, ' ')
+		}
+		buf = append(buf, e...)
+		buf = append(buf, ':')
+		buf = strconv.AppendInt(buf, int64(counts[e]), 10)
+	}
+	return string(buf)
+}
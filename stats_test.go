@@ -0,0 +1,118 @@
+package rslp
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	rs, err := LoadRules("test", strings.NewReader(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewStats(Options{Rules: rs})
+	// "beira" is too short for the eira rule, "ovo" too short to be stemmed.
+	for _, word := range []string{"bons", "lápis", "mais", "chefonas", "beira", "cabeira", "ovo", "menino"} {
+		if got, want := s.Stem(word), (Options{Rules: rs}).Stem(word); got != want {
+			t.Fatalf("invalid stem output, %q -> %q (got %q)", word, want, got)
+		}
+	}
+
+	want := []RuleStats{
+		{Step: "Plural", Rule: 0, Suffix: "ns", Replacement: "m", Applied: 1},
+		{Step: "Plural", Rule: 1, Suffix: "s", Applied: 1, Exception: 2, Exceptions: map[string]int{"lápis": 1, "mais": 1}},
+		{Step: "Feminine", Rule: 0, Suffix: "ona", Replacement: "ão", Applied: 1},
+		{Step: "Feminine", Rule: 1, Suffix: "eira", Replacement: "eiro", Exception: 1, Exceptions: map[string]int{"beira": 1}, Length: 1},
+		{Step: "Vowel", Rule: 0, Suffix: "o", Applied: 1},
+	}
+	if got := s.Rules(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid stats\nwant %+v\n got %+v", want, got)
+	}
+	if s.Words() != 8 {
+		t.Fatalf("invalid number of words, want 8 (got %d)", s.Words())
+	}
+	if dead := s.Dead(); len(dead) != 0 {
+		t.Fatalf("unexpected dead rules %+v", dead)
+	}
+}
+
+func TestStatsDead(t *testing.T) {
+	s := NewStats(Options{})
+	s.Stem("casas")
+
+	dead := s.Dead()
+	if len(dead) == 0 || len(dead) >= len(s.Rules()) {
+		t.Fatalf("invalid number of dead rules %d of %d", len(dead), len(s.Rules()))
+	}
+	for _, r := range dead {
+		if r.Step == "Plural" && r.Suffix == "s" {
+			t.Fatal("the rule applied to casas is dead")
+		}
+	}
+}
+
+func TestStatsConcurrent(t *testing.T) {
+	s := NewStats(Options{})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, tt := range stemTests {
+				s.Stem(tt.input)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.Words() != 8*len(stemTests) {
+		t.Fatalf("invalid number of words, want %d (got %d)", 8*len(stemTests), s.Words())
+	}
+}
+
+func TestStatsWrite(t *testing.T) {
+	rs, err := LoadRules("test", strings.NewReader(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewStats(Options{Rules: rs})
+	for _, word := range []string{"lápis", "mais", "mais"} {
+		s.Stem(word)
+	}
+
+	var buf bytes.Buffer
+	if err := s.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `step,rule,suffix,replacement,applied,blocked_by_exception,blocked_by_length,exceptions
+Plural,0,ns,m,0,0,0,
+Plural,1,s,,0,3,0,mais:2 lápis:1
+Feminine,0,ona,ão,0,0,0,
+Feminine,1,eira,eiro,0,0,0,
+Vowel,0,o,,0,0,0,
+`
+	if buf.String() != want {
+		t.Fatalf("invalid CSV output, want\n%s\ngot\n%s", want, buf.String())
+	}
+
+	buf.Reset()
+	if err := s.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		Rules string      `json:"rules"`
+		Words int         `json:"words"`
+		Stats []RuleStats `json:"stats"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Rules != "test" || got.Words != 3 || !reflect.DeepEqual(got.Stats, s.Rules()) {
+		t.Fatalf("invalid JSON output\n%s", buf.String())
+	}
+}
@@ -1,8 +1,11 @@
 package rslp
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/text/runes"
 	"golang.org/x/text/transform"
@@ -16,27 +19,73 @@ type rule struct {
 	exceptions  []string
 }
 
-func (r *rule) apply(word string) (string, bool) {
-	if len(word) < r.minLength+len(r.suffix) {
-		return word, false
+// ruleEvent is the outcome of a rule whose suffix matched a word.
+type ruleEvent int
+
+const (
+	ruleApplied   ruleEvent = iota // the rule changed the word
+	ruleException                  // an exception blocked the rule
+	ruleLength                     // the word was too short for the rule
+)
+
+// apply applies the rule to the word. The exceptions are compared with the
+// entire word, or with its end when entireWord is false. When chars is set
+// the lengths are counted in characters and the rule never removes the
+// entire word, otherwise they are counted in bytes.
+func (r *rule) apply(word string, entireWord, chars bool) (string, bool) {
+	word, event, ok := r.match(word, entireWord, chars, false)
+	return word, ok && event == ruleApplied
+}
+
+// match applies the rule to the word like apply does. It reports whether
+// the suffix of the rule matched the word and, if so, the outcome of the
+// rule.
+func (r *rule) match(word string, entireWord, chars, orthography bool) (string, ruleEvent, bool) {
+	if !strings.HasSuffix(word, r.suffix) {
+		return word, 0, false
+	}
+	// never cut a multi-byte rune in half, which could happen with a
+	// suffix that is not valid UTF-8.
+	if i := len(word) - len(r.suffix); i < len(word) && !utf8.RuneStart(word[i]) {
+		return word, 0, false
 	}
 
-	for _, e := range r.exceptions {
-		if word == e {
-			return word, false
+	if chars {
+		n, s := utf8.RuneCountInString(word), utf8.RuneCountInString(r.suffix)
+		if n <= s || n-s < r.minLength {
+			return word, ruleLength, true
 		}
+	} else if len(word) < r.minLength+len(r.suffix) {
+		return word, ruleLength, true
 	}
-	if strings.HasSuffix(word, r.suffix) {
-		return word[:len(word)-len(r.suffix)] + r.replacement, true
+
+	if r.exception(word, entireWord, orthography) != "" {
+		return word, ruleException, true
 	}
-	return word, false
+	return word[:len(word)-len(r.suffix)] + r.replacement, ruleApplied, true
+}
+
+// exception returns the exception of the rule matching the word, or an
+// empty string if there is none. With orthography, the exceptions are
+// compared in the spelling of normalizeOrthography, like the word.
+func (r *rule) exception(word string, entireWord, orthography bool) string {
+	for _, e := range r.exceptions {
+		spelled := e
+		if orthography {
+			spelled = orthographicException(e)
+		}
+		if word == spelled || !entireWord && strings.HasSuffix(word, spelled) {
+			return e
+		}
+	}
+	return ""
 }
 
 type step struct {
 	stepPass   string
 	stepFail   string
 	minLength  int
-	entireWord bool
+	entireWord bool // compare the exceptions with the entire word, not its end
 	endWords   []string
 	rules      []rule
 }
@@ -56,7 +105,7 @@ type step struct {
 var steps = map[string]*step{
 
 	// Step 1: Plural Reduction
-	"Plural": {"Feminine", "Feminine", 3, false, []string{"s"}, []rule{
+	"Plural": {"Feminine", "Feminine", 3, true, []string{"s"}, []rule{
 		{"ns", 1, "m", nil},
 		{"\u00f5es", 3, "\u00e3o", nil},
 		{"\u00e3es", 1, "\u00e3o", []string{"m\u00e3e"}},
@@ -75,7 +124,7 @@ var steps = map[string]*step{
 	}},
 
 	// Step 2: Feminine Reduction
-	"Feminine": {"Augmentative", "Augmentative", 3, false, []string{"a"}, []rule{
+	"Feminine": {"Augmentative", "Augmentative", 3, true, []string{"a"}, []rule{
 		{"ona", 3, "\u00e3o", []string{
 			"abandona", "lona", "iona", "cortisona", "mon\u00f3tona", "maratona", "acetona", "detona", "carona",
 		}},
@@ -104,12 +153,12 @@ var steps = map[string]*step{
 	}},
 
 	// Step 3: Adverb Reduction
-	"Adverb": {"Noun", "Noun", 0, false, nil, []rule{
+	"Adverb": {"Noun", "Noun", 0, true, nil, []rule{
 		{"mente", 4, "", []string{"experimente"}},
 	}},
 
 	// Step 4: Augmentative/Diminutive Reduction
-	"Augmentative": {"Adverb", "Adverb", 0, false, nil, []rule{
+	"Augmentative": {"Adverb", "Adverb", 0, true, nil, []rule{
 		{"d\u00edssimo", 5, "", nil},
 		{"abil\u00edssimo", 5, "", nil},
 		{"\u00edssimo", 3, "", nil},
@@ -143,7 +192,7 @@ var steps = map[string]*step{
 	}},
 
 	// Step 5: Noun Suffix Reduction
-	"Noun": {"", "Verb", 0, false, nil, []rule{
+	"Noun": {"", "Verb", 0, true, nil, []rule{
 
 		{"encialista", 4, "", nil},
 		{"alista", 5, "", nil},
@@ -233,7 +282,7 @@ var steps = map[string]*step{
 	}},
 
 	// Step 6: Verb Suffix Reduction
-	"Verb": {"", "Vowel", 0, false, nil, []rule{
+	"Verb": {"", "Vowel", 0, true, nil, []rule{
 		{"ar\u00edamo", 2, "", nil},
 		{"\u00e1ssemo", 2, "", nil},
 		{"er\u00edamo", 2, "", nil},
@@ -341,7 +390,7 @@ var steps = map[string]*step{
 	}},
 
 	// Step 7: Vowel Removal
-	"Vowel": {"", "", 0, false, nil, []rule{
+	"Vowel": {"", "", 0, true, nil, []rule{
 		{"bil", 2, "vel", nil},
 		{"gue", 2, "g", []string{"gangue", "jegue"}},
 		{"á", 3, "", nil},
@@ -356,61 +405,256 @@ var steps = map[string]*step{
 
 var normalize = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
 
+// Stemmer is implemented by the stemmers of this package, so that they can be
+// used and evaluated interchangeably.
+type Stemmer interface {
+	// Stem returns the stem of a single word.
+	Stem(word string) string
+}
+
+var (
+	_ Stemmer = Options{}
+	_ Stemmer = Snowball{}
+)
+
+// ErrInvalidUTF8 is returned by StemE when the word is not valid UTF-8.
+var ErrInvalidUTF8 = errors.New("rslp: invalid UTF-8")
+
+// Options configures how words are stemmed. The zero value stems words the
+// same way Stem does by default.
+type Options struct {
+	// KeepDiacritics keeps the diacritics of the stemmed words, which are
+	// removed by default.
+	KeepDiacritics bool
+
+	// PreserveCase keeps the casing pattern of the input word (all-caps,
+	// title-case or mixed) in the returned stem. Rules are always matched
+	// case-insensitively.
+	PreserveCase bool
+
+	// Rules is the rule set used to stem the words. It defaults to
+	// Portuguese when nil.
+	Rules *RuleSet
+
+	// Orthography normalizes the spelling of the words before the steps
+	// run, so that European Portuguese and pre-1990 spellings ("acção",
+	// "óptimo", "freqüente") conflate with the Brazilian ones ("ação",
+	// "ótimo", "frequente"). The exceptions of the rules are normalized
+	// the same way.
+	Orthography bool
+
+	// DetachClitics removes the enclitic and mesoclitic pronouns of the
+	// verbs and restores their endings before the steps run, so that
+	// "vendê-lo" is stemmed as "vender" and "dir-se-á" as "dirá".
+	DetachClitics bool
+
+	// ExpandContractions splits the contractions of prepositions ("do",
+	// "pelas", "num", "àquela") into their components ("de o", "por as",
+	// "em um", "a aquela") before the words of a sentence are stemmed.
+	ExpandContractions bool
+
+	// Stopwords are dropped by StemSentence and flagged by Tokens when not
+	// nil. See BrazilianStopwords and EuropeanStopwords.
+	Stopwords *Stopwords
+}
+
+// rules returns the rule set of the options.
+func (o Options) rules() *RuleSet {
+	if o.Rules == nil {
+		return Portuguese
+	}
+	return o.Rules
+}
+
+// options returns the Options equivalent to the removeDiacritics argument
+// accepted by Stem and StemSentence.
+func options(removeDiacritics []bool) Options {
+	return Options{KeepDiacritics: len(removeDiacritics) > 0 && !removeDiacritics[0]}
+}
+
 // Stems a sentence. It returns the same sentence but with all words stemmed.
 func StemSentence(sentence string, removeDiacritics ...bool) string {
+	return options(removeDiacritics).StemSentence(sentence)
+}
+
+// Stems a single word. It returns the stemmed word.
+func Stem(word string, removeDiacritics ...bool) string {
+	return options(removeDiacritics).Stem(word)
+}
+
+// StemSentence stems a sentence using the options. It returns the same
+// sentence but with all words stemmed and the stopwords removed.
+func (o Options) StemSentence(sentence string) string {
+	var buf strings.Builder
+	n := 0
+	for _, t := range o.Tokens(sentence) {
+		if t.Stopword {
+			continue
+		}
+		if n > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(t.Stem)
+		n++
+	}
+	return buf.String()
+}
+
+// stemSentence stems each word of the sentence with stem and joins the stems
+// with single spaces.
+func stemSentence(sentence string, stem func(string) string) string {
 	var buf strings.Builder
 	for index, word := range strings.Fields(sentence) {
 		if index > 0 {
 			buf.WriteByte(' ')
 		}
-		buf.WriteString(Stem(word, removeDiacritics...))
+		buf.WriteString(stem(word))
 	}
 	return buf.String()
 }
 
-// Stems a single word. It returns the stemmed word.
-func Stem(word string, removeDiacritics ...bool) string {
-	if len(word) <= 3 {
-		return strings.TrimSpace(strings.ToLower(word))
+// StemE stems a single word like Stem does, but reports an error if the word
+// is not valid UTF-8 or its diacritics could not be removed.
+func StemE(word string, removeDiacritics ...bool) (string, error) {
+	return options(removeDiacritics).StemE(word)
+}
+
+// Stem stems a single word using the options. It returns the stemmed word,
+// which is always valid UTF-8: invalid bytes in the word are replaced by the
+// Unicode replacement character before stemming.
+func (o Options) Stem(word string) string {
+	word = strings.ToValidUTF8(word, string(utf8.RuneError))
+	stem, _ := o.stem(word, nil)
+	return stem
+}
+
+// StemE stems a single word using the options. It returns ErrInvalidUTF8 if
+// the word is not valid UTF-8, or the normalization error if its diacritics
+// could not be removed.
+func (o Options) StemE(word string) (string, error) {
+	if !utf8.ValidString(word) {
+		return "", ErrInvalidUTF8
+	}
+	stem, err := o.stem(word, nil)
+	if err != nil {
+		return "", err
 	}
+	return stem, nil
+}
 
-	word = strings.TrimSpace(strings.ToLower(word))
+// tracer is notified of every rule whose suffix matched a word while it is
+// stemmed, along with the outcome of the rule. The after word differs from
+// the before one only if the rule was applied.
+type tracer func(step string, r *rule, event ruleEvent, before, after string)
 
-	var ok bool
-	var cur *step
-	cur = steps["Plural"]
+// stem stems a valid UTF-8 word, notifying t of the applied rules when it is
+// not nil. On normalization failure it returns the stem with its diacritics
+// along with the error.
+func (o Options) stem(word string, t tracer) (string, error) {
+	rs := o.rules()
 
-	for cur != nil {
-		if word, ok = applyStep(word, cur); !ok {
-			cur = steps[cur.stepFail]
-		} else {
-			cur = steps[cur.stepPass]
+	if len(word) <= 3 && !rs.lucene {
+		word = strings.TrimSpace(word)
+		if o.PreserveCase {
+			return word, nil
 		}
+		return strings.ToLower(word), nil
 	}
 
-	if len(removeDiacritics) == 0 || removeDiacritics[0] {
-		if s, _, e := transform.String(normalize, word); e == nil {
-			return s
+	original := strings.TrimSpace(word)
+	word = strings.ToLower(original)
+	if o.Orthography {
+		word = normalizeOrthography(word)
+	}
+	if o.DetachClitics {
+		// short verbs left by the pronouns are kept, like other short words.
+		if word = detachClitics(word); len(word) <= 3 && !rs.lucene {
+			if o.PreserveCase {
+				return restoreCase(original, word), nil
+			}
+			return word, nil
 		}
 	}
+	word = rs.run(word, o.Orthography, t)
 
-	return word
+	var err error
+	if !o.KeepDiacritics {
+		// a word made only of combining marks would vanish from sentences,
+		// so it keeps its diacritics.
+		var s string
+		if s, _, err = transform.String(folds[rs.fold], word); err == nil && s != "" {
+			word = s
+		} else if err != nil {
+			err = fmt.Errorf("rslp: removing diacritics of %q: %w", word, err)
+		}
+	}
+
+	if o.PreserveCase {
+		return restoreCase(original, word), err
+	}
+	return word, err
+}
+
+// restoreCase applies the casing pattern of word to its lowercase stem. An
+// all-caps word gives an all-caps stem, otherwise each letter of the stem
+// takes the case of the letter at the same position in word.
+func restoreCase(word, stem string) string {
+	if strings.ToLower(word) == word {
+		return stem
+	}
+	if strings.ToUpper(word) == word {
+		return strings.ToUpper(stem)
+	}
+
+	letters := []rune(word)
+
+	var buf strings.Builder
+	n := 0
+	for _, r := range stem {
+		if n < len(letters) && unicode.IsUpper(letters[n]) {
+			r = unicode.ToUpper(r)
+		}
+		buf.WriteRune(r)
+		n++
+	}
+	return buf.String()
 }
 
 func applyStep(word string, cur *step) (string, bool) {
-	if cur.minLength > 0 && len(word) < cur.minLength {
-		return word, false
+	word, r := matchStep(word, cur, false, false, nil)
+	return word, r != nil
+}
+
+// matchStep applies the first matching rule of the step to the word. It
+// returns the resulting word and the applied rule, or nil if none applied.
+// When chars is set the lengths are counted in characters instead of bytes.
+// The rules whose suffix matched the word are passed to notify when it is
+// not nil.
+func matchStep(word string, cur *step, chars, orthography bool, notify func(r *rule, event ruleEvent, after string)) (string, *rule) {
+	length := len(word)
+	if chars {
+		length = utf8.RuneCountInString(word)
+	}
+
+	if cur.minLength > 0 && length < cur.minLength {
+		return word, nil
 	} else if !hasSuffix(word, cur.endWords...) {
-		return word, false
+		return word, nil
 	}
 
-	var ok bool
-	for _, r := range cur.rules {
-		if word, ok = r.apply(word); ok {
-			return word, true
+	for i := range cur.rules {
+		after, event, ok := cur.rules[i].match(word, cur.entireWord, chars, orthography)
+		if !ok {
+			continue
+		}
+		if notify != nil {
+			notify(&cur.rules[i], event, after)
+		}
+		if event == ruleApplied {
+			return after, &cur.rules[i]
 		}
 	}
-	return word, false
+	return word, nil
 }
 
 func hasSuffix(word string, suffix ...string) bool {
@@ -0,0 +1,168 @@
+//go:build go1.18
+// +build go1.18
+
+package rslp
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+// longestReplacement returns the length, in runes, of the longest
+// replacement of the built-in rules.
+func longestReplacement() int {
+	longest := 0
+	for _, s := range steps {
+		for _, r := range s.rules {
+			if n := utf8.RuneCountInString(r.replacement); n > longest {
+				longest = n
+			}
+		}
+	}
+	return longest
+}
+
+func isAlphabetic(word string) bool {
+	word = strings.TrimSpace(word)
+	if word == "" {
+		return false
+	}
+	for _, r := range word {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func FuzzStem(f *testing.F) {
+	for _, tt := range stemTests {
+		f.Add(tt.input, true)
+	}
+	for _, tt := range sentenceTests {
+		for _, word := range strings.Fields(tt.input) {
+			f.Add(word, tt.removeAccents)
+		}
+	}
+
+	longest := longestReplacement()
+
+	f.Fuzz(func(t *testing.T, word string, removeDiacritics bool) {
+		got := Stem(word, removeDiacritics)
+
+		if !utf8.ValidString(got) {
+			t.Fatalf("Stem(%q) = %q is not valid UTF-8", word, got)
+		}
+		if got == "" && isAlphabetic(word) {
+			t.Fatalf("Stem(%q) is empty", word)
+		}
+		if n, max := utf8.RuneCountInString(got), utf8.RuneCountInString(word)+longest; n > max {
+			t.Fatalf("Stem(%q) = %q has %d runes, want at most %d", word, got, n, max)
+		}
+	})
+}
+
+func FuzzSnowball(f *testing.F) {
+	for _, tt := range stemTests {
+		f.Add(tt.input)
+	}
+	f.Add("quintessência")
+	f.Add("a~o~")
+
+	f.Fuzz(func(t *testing.T, word string) {
+		got := Snowball{}.Stem(word)
+
+		if !utf8.ValidString(got) {
+			t.Fatalf("Snowball.Stem(%q) = %q is not valid UTF-8", word, got)
+		}
+		lower := strings.ToLower(strings.ToValidUTF8(word, string(utf8.RuneError)))
+		if n, max := utf8.RuneCountInString(got), utf8.RuneCountInString(lower); n > max {
+			t.Fatalf("Snowball.Stem(%q) = %q has %d runes, want at most %d", word, got, n, max)
+		}
+	})
+}
+
+func FuzzStemLight(f *testing.F) {
+	for _, tt := range stemTests {
+		f.Add(tt.input, true)
+	}
+
+	f.Fuzz(func(t *testing.T, word string, removeDiacritics bool) {
+		got := StemLight(word, removeDiacritics)
+
+		if !utf8.ValidString(got) {
+			t.Fatalf("StemLight(%q) = %q is not valid UTF-8", word, got)
+		}
+		if got == "" && isAlphabetic(word) {
+			t.Fatalf("StemLight(%q) is empty", word)
+		}
+	})
+}
+
+func FuzzStemSentence(f *testing.F) {
+	for _, tt := range sentenceTests {
+		f.Add(tt.input, tt.removeAccents)
+	}
+	for _, tt := range stemTests {
+		f.Add(tt.input, true)
+	}
+	f.Add("a \u0301\u0301\u0301\u0301 b", true)
+
+	f.Fuzz(func(t *testing.T, sentence string, removeDiacritics bool) {
+		got := StemSentence(sentence, removeDiacritics)
+
+		if !utf8.ValidString(got) {
+			t.Fatalf("StemSentence(%q) = %q is not valid UTF-8", sentence, got)
+		}
+		if n, want := len(strings.Fields(got)), len(strings.Fields(sentence)); n != want {
+			t.Fatalf("StemSentence(%q) = %q has %d tokens, want %d", sentence, got, n, want)
+		}
+	})
+}
+
+func FuzzStemE(f *testing.F) {
+	for _, tt := range stemTests {
+		f.Add(tt.input)
+	}
+	f.Add("\xc3")
+	f.Add("a\xffmente")
+
+	f.Fuzz(func(t *testing.T, word string) {
+		got, err := StemE(word)
+		if !utf8.ValidString(word) {
+			if err != ErrInvalidUTF8 {
+				t.Fatalf("StemE(%q) error = %v, want %v", word, err, ErrInvalidUTF8)
+			}
+		} else if err != nil {
+			t.Fatalf("StemE(%q) unexpected error: %v", word, err)
+		} else if !utf8.ValidString(got) {
+			t.Fatalf("StemE(%q) = %q is not valid UTF-8", word, got)
+		}
+
+		if got := Stem(word); !utf8.ValidString(got) {
+			t.Fatalf("Stem(%q) = %q is not valid UTF-8", word, got)
+		}
+	})
+}
+
+func FuzzLoadRules(f *testing.F) {
+	f.Add(testRules, "meninos")
+	f.Add(lucenePortugueseRules, "cantárei")
+	f.Add(galicianRules, "cantaredes")
+	for _, tt := range stemTests {
+		f.Add("{ \"Plural\", 3, 0, {\"s\"},\n{\"s\",2,\"\"}};", tt.input)
+	}
+
+	f.Fuzz(func(t *testing.T, rules string, word string) {
+		rs, err := LoadRules("fuzz", strings.NewReader(rules))
+		if err != nil {
+			return
+		}
+
+		if got := (Options{Rules: rs}).Stem(word); !utf8.ValidString(got) {
+			t.Fatalf("Stem(%q) = %q is not valid UTF-8", word, got)
+		}
+	})
+}
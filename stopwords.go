@@ -0,0 +1,111 @@
+package rslp
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Stopwords is a list of words, such as articles and prepositions, that are
+// too frequent to be useful in a search. Besides the built-in lists, others
+// can be loaded with LoadStopwords or created with NewStopwords.
+type Stopwords struct {
+	name    string
+	version string
+	words   map[string]bool
+}
+
+//go:embed stopwords/pt-br.txt
+var brazilianStopwords string
+
+//go:embed stopwords/pt-pt.txt
+var europeanStopwords string
+
+var (
+	// BrazilianStopwords are the stopwords of Brazilian Portuguese.
+	BrazilianStopwords = mustLoadStopwords("pt-br", brazilianStopwords)
+
+	// EuropeanStopwords are the stopwords of European Portuguese.
+	EuropeanStopwords = mustLoadStopwords("pt-pt", europeanStopwords)
+)
+
+// versionPattern matches the version header of a stopword file.
+var versionPattern = regexp.MustCompile(`^#\s*version:\s*(\S+)\s*$`)
+
+// NewStopwords returns a list with the given words.
+func NewStopwords(name string, words ...string) *Stopwords {
+	sw := &Stopwords{name: name, words: make(map[string]bool, len(words))}
+	for _, w := range words {
+		sw.words[strings.ToLower(strings.TrimSpace(w))] = true
+	}
+	return sw
+}
+
+// LoadStopwords reads a stopword file, with one word per line. Empty lines
+// and lines starting with '#' are ignored, except for a "# version: v" line
+// which sets the version of the list.
+func LoadStopwords(name string, r io.Reader) (*Stopwords, error) {
+	sw := NewStopwords(name)
+
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if !utf8.ValidString(line) {
+			return nil, fmt.Errorf("rslp: %s:%d: invalid UTF-8", name, n)
+		}
+		if m := versionPattern.FindStringSubmatch(line); m != nil {
+			sw.version = m[1]
+		}
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		sw.words[strings.ToLower(line)] = true
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("rslp: reading %s: %w", name, err)
+	}
+	return sw, nil
+}
+
+func mustLoadStopwords(name, words string) *Stopwords {
+	sw, err := LoadStopwords(name, strings.NewReader(words))
+	if err != nil {
+		panic(err)
+	}
+	return sw
+}
+
+// Name returns the name of the list.
+func (sw *Stopwords) Name() string {
+	return sw.name
+}
+
+// Version returns the version of the list, or an empty string if it has
+// none.
+func (sw *Stopwords) Version() string {
+	return sw.version
+}
+
+// Len returns the number of words of the list.
+func (sw *Stopwords) Len() int {
+	return len(sw.words)
+}
+
+// Contains reports whether the word is in the list. The word is compared
+// case-insensitively and without the punctuation around it.
+func (sw *Stopwords) Contains(word string) bool {
+	return sw.words[strings.ToLower(trimPunctuation(word))]
+}
+
+// trimPunctuation removes the characters other than letters and digits
+// around the word.
+func trimPunctuation(word string) string {
+	return strings.TrimFunc(word, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,95 @@
+package rslp
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestStopwords(t *testing.T) {
+	tests := []struct {
+		stopwords *Stopwords
+		word      string
+		want      bool
+	}{
+		{BrazilianStopwords, "que", true},
+		{BrazilianStopwords, "Você,", true},
+		{BrazilianStopwords, "conosco", true},
+		{BrazilianStopwords, "connosco", false},
+		{BrazilianStopwords, "casa", false},
+		{EuropeanStopwords, "connosco", true},
+		{EuropeanStopwords, "vós", true},
+		{EuropeanStopwords, "do", true},
+		{EuropeanStopwords, "conosco", false},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			if got := tt.stopwords.Contains(tt.word); got != tt.want {
+				t.Fatalf("invalid stopword output, %s: %q -> %v (got %v)", tt.stopwords.Name(), tt.word, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStopwordsVersion(t *testing.T) {
+	for _, sw := range []*Stopwords{BrazilianStopwords, EuropeanStopwords} {
+		if sw.Version() != "1" {
+			t.Fatalf("invalid version of %s, want %q (got %q)", sw.Name(), "1", sw.Version())
+		}
+		if sw.Len() < 200 {
+			t.Fatalf("%s has only %d words", sw.Name(), sw.Len())
+		}
+	}
+}
+
+func TestLoadStopwords(t *testing.T) {
+	sw, err := LoadStopwords("custom", strings.NewReader("# version: 2022.1\n\nFulano\n# comment\nbeltrano\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sw.Name() != "custom" || sw.Version() != "2022.1" || sw.Len() != 2 {
+		t.Fatalf("invalid stopwords %q %q %d", sw.Name(), sw.Version(), sw.Len())
+	}
+	if !sw.Contains("fulano") || !sw.Contains("Beltrano") || sw.Contains("comment") {
+		t.Fatalf("invalid stopwords %v", sw.words)
+	}
+
+	if _, err := LoadStopwords("invalid", strings.NewReader("ok\n\xff\n")); err == nil {
+		t.Fatal("expected an error for invalid UTF-8")
+	}
+}
+
+func TestStemSentenceStopwords(t *testing.T) {
+	tests := []struct {
+		opts  Options
+		input string
+		want  string
+	}{
+		{
+			Options{Stopwords: BrazilianStopwords},
+			"Que você compartilhe livremente, nunca recebendo mais do que você dá.",
+			"compartilh livremente, nunc receb da.",
+		},
+		{
+			Options{Stopwords: EuropeanStopwords, ExpandContractions: true},
+			"Voltou daquela cidade connosco",
+			"volt cidad",
+		},
+		{
+			Options{Stopwords: NewStopwords("custom", "nunca")},
+			"nunca recebendo",
+			"receb",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := tt.opts.StemSentence(tt.input)
+
+			if tt.want != got {
+				t.Fatalf("invalid stem output, %q -> %q (got %q)", tt.input, tt.want, got)
+			}
+		})
+	}
+}
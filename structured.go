@@ -0,0 +1,269 @@
+package rslp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Structured rule files declare the same steps and rules as the RSLP format,
+// in JSON or YAML, along with examples that document and verify them:
+//
+//	name: portuguese
+//	version: 1
+//	steps:
+//	  - name: Plural
+//	    pass: Feminine
+//	    fail: Feminine
+//	    minLength: 3
+//	    entireWord: true
+//	    endWords: [s]
+//	    rules:
+//	      - suffix: ns
+//	        minLength: 1
+//	        replacement: m
+//	        examples:
+//	          - {input: bons, output: bom}
+//	      - suffix: s
+//	        minLength: 2
+//	        exceptions: [lápis, mais]
+//	        counterexamples: [lápis]
+//
+// The examples of a rule are words the rule is applied to by its step, with
+// the resulting word, and its counterexamples are words the rule must not be
+// applied to. The examples and counterexamples of a step are the same for
+// the step as a whole. Without pass or fail, a step goes to the next one in
+// the file, and the rule set starts with the first step unless start is set.
+type structuredRules struct {
+	Name    string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Version string           `json:"version,omitempty" yaml:"version,omitempty"`
+	Start   string           `json:"start,omitempty" yaml:"start,omitempty"`
+	Lucene  bool             `json:"lucene,omitempty" yaml:"lucene,omitempty"`
+	Fold    string           `json:"fold,omitempty" yaml:"fold,omitempty"`
+	Steps   []structuredStep `json:"steps" yaml:"steps"`
+}
+
+type structuredStep struct {
+	Name       string           `json:"name" yaml:"name"`
+	Pass       *string          `json:"pass,omitempty" yaml:"pass,omitempty"`
+	Fail       *string          `json:"fail,omitempty" yaml:"fail,omitempty"`
+	MinLength  int              `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	EntireWord bool             `json:"entireWord,omitempty" yaml:"entireWord,omitempty"`
+	EndWords   []string         `json:"endWords,omitempty" yaml:"endWords,omitempty"`
+	Rules      []structuredRule `json:"rules" yaml:"rules"`
+
+	Examples        []structuredExample `json:"examples,omitempty" yaml:"examples,omitempty"`
+	Counterexamples []string            `json:"counterexamples,omitempty" yaml:"counterexamples,omitempty"`
+}
+
+type structuredRule struct {
+	Suffix      string   `json:"suffix" yaml:"suffix"`
+	MinLength   int      `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	Replacement string   `json:"replacement,omitempty" yaml:"replacement,omitempty"`
+	Exceptions  []string `json:"exceptions,omitempty" yaml:"exceptions,omitempty"`
+
+	Examples        []structuredExample `json:"examples,omitempty" yaml:"examples,omitempty"`
+	Counterexamples []string            `json:"counterexamples,omitempty" yaml:"counterexamples,omitempty"`
+}
+
+type structuredExample struct {
+	Input  string `json:"input" yaml:"input"`
+	Output string `json:"output" yaml:"output"`
+}
+
+// examples are the examples and counterexamples of a step or a rule.
+type examples struct {
+	examples        []structuredExample
+	counterexamples []string
+}
+
+// LoadRulesJSON loads a rule set from a structured rule file in JSON.
+func LoadRulesJSON(name string, r io.Reader) (*RuleSet, error) {
+	var sr structuredRules
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&sr); err != nil {
+		return nil, fmt.Errorf("rslp: %s: %w", name, err)
+	}
+	return sr.ruleSet(name)
+}
+
+// LoadRulesYAML loads a rule set from a structured rule file in YAML.
+func LoadRulesYAML(name string, r io.Reader) (*RuleSet, error) {
+	var sr structuredRules
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&sr); err != nil {
+		return nil, fmt.Errorf("rslp: %s: %w", name, err)
+	}
+	return sr.ruleSet(name)
+}
+
+// ruleSet builds the rule set declared by the file with the given name.
+func (sr *structuredRules) ruleSet(name string) (*RuleSet, error) {
+	errorf := func(format string, args ...interface{}) error {
+		return fmt.Errorf("rslp: %s: %s", name, fmt.Sprintf(format, args...))
+	}
+
+	rs := &RuleSet{
+		name:     name,
+		version:  sr.Version,
+		start:    sr.Start,
+		steps:    map[string]*step{},
+		lucene:   sr.Lucene,
+		fold:     "normalize",
+		examples: map[rulePosition]*examples{},
+	}
+	if sr.Name != "" {
+		rs.name = sr.Name
+	}
+	if sr.Fold != "" {
+		if _, ok := folds[sr.Fold]; !ok {
+			return nil, errorf("unknown fold %q", sr.Fold)
+		}
+		rs.fold = sr.Fold
+	}
+	if len(sr.Steps) == 0 {
+		return nil, errorf("no steps")
+	}
+
+	for i, ss := range sr.Steps {
+		if ss.Name == "" {
+			return nil, errorf("step %d has no name", i)
+		}
+		if _, dup := rs.steps[ss.Name]; dup {
+			return nil, errorf("duplicate step %q", ss.Name)
+		}
+		if len(ss.Rules) == 0 {
+			return nil, errorf("step %q has no rules", ss.Name)
+		}
+
+		s := &step{minLength: ss.MinLength, entireWord: ss.EntireWord, endWords: ss.EndWords}
+		if i+1 < len(sr.Steps) {
+			s.stepPass, s.stepFail = sr.Steps[i+1].Name, sr.Steps[i+1].Name
+		}
+		if ss.Pass != nil {
+			s.stepPass = *ss.Pass
+		}
+		if ss.Fail != nil {
+			s.stepFail = *ss.Fail
+		}
+		rs.addExamples(rulePosition{ss.Name, -1}, ss.Examples, ss.Counterexamples)
+
+		for j, r := range ss.Rules {
+			if r.Suffix == "" {
+				return nil, errorf("rule %d of step %q has an empty suffix", j, ss.Name)
+			}
+			if !utf8.ValidString(r.Suffix) || !utf8.ValidString(r.Replacement) {
+				return nil, errorf("rule %d of step %q is not valid UTF-8", j, ss.Name)
+			}
+			s.rules = append(s.rules, rule{r.Suffix, r.MinLength, r.Replacement, r.Exceptions})
+			rs.addExamples(rulePosition{ss.Name, j}, r.Examples, r.Counterexamples)
+		}
+
+		rs.steps[ss.Name] = s
+		rs.order = append(rs.order, ss.Name)
+	}
+
+	if rs.start == "" {
+		rs.start = rs.order[0]
+	} else if rs.steps[rs.start] == nil {
+		return nil, errorf("start at undeclared step %q", rs.start)
+	}
+	for _, name := range rs.order {
+		s := rs.steps[name]
+		for _, next := range []string{s.stepPass, s.stepFail} {
+			if next != "" && rs.steps[next] == nil {
+				return nil, errorf("flow of %q to undeclared step %q", name, next)
+			}
+		}
+	}
+	return rs, nil
+}
+
+// addExamples adds the examples of the step or rule at the position.
+func (rs *RuleSet) addExamples(pos rulePosition, ex []structuredExample, counter []string) {
+	if len(ex) > 0 || len(counter) > 0 {
+		rs.examples[pos] = &examples{ex, counter}
+	}
+}
+
+// ExampleFailure is an example or counterexample of a structured rule file
+// that its step or rule doesn't stem as declared.
+type ExampleFailure struct {
+	Step string
+	Rule int // index of the rule in its step, or -1 for the step itself
+
+	// Input is the example, Want its declared output and Got the output of
+	// the step. Want is empty for a counterexample.
+	Input, Want, Got string
+	Counterexample   bool
+}
+
+func (f ExampleFailure) String() string {
+	at := f.Step
+	if f.Rule >= 0 {
+		at = fmt.Sprintf("%s/%d", f.Step, f.Rule)
+	}
+	if f.Counterexample {
+		return fmt.Sprintf("%s: counterexample %q -> %q", at, f.Input, f.Got)
+	}
+	return fmt.Sprintf("%s: example %q -> %q (got %q)", at, f.Input, f.Want, f.Got)
+}
+
+// Verify checks the examples and counterexamples of the rule set, declared
+// in its structured rule file, and returns those that fail in the order of
+// the steps and rules. The examples are run through their step alone, after
+// lowercasing.
+func (rs *RuleSet) Verify() []ExampleFailure {
+	positions := make([]rulePosition, 0, len(rs.examples))
+	for pos := range rs.examples {
+		positions = append(positions, pos)
+	}
+	index := make(map[string]int, len(rs.order))
+	for i, name := range rs.order {
+		index[name] = i
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		a, b := positions[i], positions[j]
+		if a.step != b.step {
+			return index[a.step] < index[b.step]
+		}
+		return a.index < b.index
+	})
+
+	var failures []ExampleFailure
+	for _, pos := range positions {
+		s := rs.steps[pos.step]
+		if s == nil || pos.index >= len(s.rules) {
+			continue
+		}
+		// applied reports whether the step, or the rule, is applied to the
+		// word, and the resulting word.
+		applied := func(word string) (string, bool) {
+			after, r := matchStep(strings.ToLower(word), s, rs.lucene, false, nil)
+			if pos.index < 0 {
+				return after, r != nil
+			}
+			return after, r == &s.rules[pos.index]
+		}
+
+		ex := rs.examples[pos]
+		for _, e := range ex.examples {
+			if got, ok := applied(e.Input); !ok || got != e.Output {
+				failures = append(failures, ExampleFailure{pos.step, pos.index, e.Input, e.Output, got, false})
+			}
+		}
+		for _, c := range ex.counterexamples {
+			if got, ok := applied(c); ok {
+				failures = append(failures, ExampleFailure{pos.step, pos.index, c, "", got, true})
+			}
+		}
+	}
+	return failures
+}
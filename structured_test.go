@@ -0,0 +1,120 @@
+package rslp
+
+import (
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// loadStructured loads a structured rule file of testdata/rules.
+func loadStructured(t *testing.T, name string) *RuleSet {
+	f, err := os.Open("testdata/rules/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	load := LoadRulesYAML
+	if strings.HasSuffix(name, ".json") {
+		load = LoadRulesJSON
+	}
+	rs, err := load(name, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rs
+}
+
+func TestLoadRulesStructured(t *testing.T) {
+	text, err := LoadRules("test", strings.NewReader(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"test.yaml", "test.json"} {
+		rs := loadStructured(t, name)
+		if rs.Name() != "test" {
+			t.Fatalf("%s: invalid name %q", name, rs.Name())
+		}
+		if !reflect.DeepEqual(rs.steps, text.steps) || rs.start != text.start || !reflect.DeepEqual(rs.order, text.order) {
+			t.Fatalf("%s: the steps differ from the rule file", name)
+		}
+		if failures := rs.Verify(); len(failures) != 0 {
+			t.Fatalf("%s: unexpected failures %v", name, failures)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	rs, err := LoadRulesYAML("test", strings.NewReader(`
+steps:
+  - name: Plural
+    endWords: [s]
+    examples:
+      - {input: lápis, output: lápi}
+    rules:
+      - suffix: ns
+        replacement: m
+        examples:
+          - {input: bons, output: bons}
+          - {input: sons, output: som}
+      - suffix: s
+        minLength: 2
+        exceptions: [mais]
+        counterexamples: [mais, casas, bons]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []ExampleFailure{
+		{Step: "Plural", Rule: 0, Input: "bons", Want: "bons", Got: "bom"},
+		{Step: "Plural", Rule: 1, Input: "casas", Got: "casa", Counterexample: true},
+	}
+	if got := rs.Verify(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid failures\nwant %v\n got %v", want, got)
+	}
+
+	if failures := Portuguese.Verify(); len(failures) != 0 {
+		t.Fatalf("unexpected failures %v", failures)
+	}
+}
+
+func TestExampleFailureString(t *testing.T) {
+	for _, tt := range []struct {
+		f    ExampleFailure
+		want string
+	}{
+		{ExampleFailure{Step: "Plural", Rule: 0, Input: "bons", Want: "bons", Got: "bom"}, `Plural/0: example "bons" -> "bons" (got "bom")`},
+		{ExampleFailure{Step: "Plural", Rule: -1, Input: "casas", Got: "casa", Counterexample: true}, `Plural: counterexample "casas" -> "casa"`},
+	} {
+		if got := tt.f.String(); got != tt.want {
+			t.Fatalf("invalid string, want %q (got %q)", tt.want, got)
+		}
+	}
+}
+
+func TestLoadRulesStructuredErrors(t *testing.T) {
+	for _, tt := range []struct {
+		load  func(string, io.Reader) (*RuleSet, error)
+		rules string
+	}{
+		{LoadRulesJSON, `{"steps": []}`},
+		{LoadRulesJSON, `{"steps": [{"name": "A", "rules": [{"suffix": "s"}]}], "unknown": 1}`},
+		{LoadRulesJSON, `{"steps": [{"name": "A", "rules": []}]}`},
+		{LoadRulesJSON, `{"steps": [{"rules": [{"suffix": "s"}]}]}`},
+		{LoadRulesJSON, `{"steps": [{"name": "A", "rules": [{"suffix": ""}]}]}`},
+		{LoadRulesJSON, `{"steps": [{"name": "A", "rules": [{"suffix": "s"}]}, {"name": "A", "rules": [{"suffix": "s"}]}]}`},
+		{LoadRulesJSON, `{"start": "B", "steps": [{"name": "A", "rules": [{"suffix": "s"}]}]}`},
+		{LoadRulesJSON, `{"fold": "ascii", "steps": [{"name": "A", "rules": [{"suffix": "s"}]}]}`},
+		{LoadRulesYAML, "steps:\n  - name: A\n    pass: B\n    rules:\n      - suffix: s\n"},
+		{LoadRulesYAML, "steps:\n  - name: A\n    rules:\n      - suffix: s\n        minLenght: 2\n"},
+		{LoadRulesYAML, "steps: ["},
+	} {
+		if _, err := tt.load("test", strings.NewReader(tt.rules)); err == nil {
+			t.Fatalf("expected an error for %q", tt.rules)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package rslp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Pair is a labeled pair of words, which should or should not share a stem.
+type Pair struct {
+	A, B     string
+	Conflate bool
+}
+
+// ReadPairs reads labeled word pairs, one per line, as the two words
+// followed by "=" when they should share a stem or "!=" when they should
+// not. Without a label the words should share a stem. Empty lines and lines
+// starting with '#' are ignored.
+func ReadPairs(r io.Reader) ([]Pair, error) {
+	var pairs []Pair
+
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(line)
+		p := Pair{Conflate: true}
+		switch {
+		case len(fields) == 2:
+		case len(fields) == 3 && fields[2] == "=":
+		case len(fields) == 3 && fields[2] == "!=":
+			p.Conflate = false
+		default:
+			return nil, fmt.Errorf("rslp: pairs:%d: invalid pair %q", n, line)
+		}
+		p.A, p.B = fields[0], fields[1]
+		pairs = append(pairs, p)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("rslp: reading pairs: %w", err)
+	}
+	return pairs, nil
+}
+
+// Suggestion is a change to a single rule of a rule set: either a new
+// exception or a new minimum length of the stem.
+type Suggestion struct {
+	Step   string
+	Rule   int // index of the rule in its step
+	Suffix string
+
+	// Exception is the exception added to the rule. When it is empty,
+	// MinLength is the new minimum length of the rule instead.
+	Exception string
+	MinLength int
+
+	// Fixed and Broken are the numbers of labeled pairs the change fixes
+	// and breaks.
+	Fixed, Broken int
+}
+
+// Net returns the net improvement of the suggestion on the labeled pairs.
+func (s Suggestion) Net() int {
+	return s.Fixed - s.Broken
+}
+
+func (s Suggestion) String() string {
+	change := fmt.Sprintf("set min length to %d", s.MinLength)
+	if s.Exception != "" {
+		change = fmt.Sprintf("add exception %q", s.Exception)
+	}
+	return fmt.Sprintf("%s/%d (-%s): %s (fixes %d, breaks %d)", s.Step, s.Rule, s.Suffix, change, s.Fixed, s.Broken)
+}
+
+// clone returns a copy of the rule set that can be changed without changing
+// the original. The copy runs the steps themselves, even if the rule set is
+// compiled.
+func (rs *RuleSet) clone() *RuleSet {
+	c := *rs
+	c.compiled = nil
+	c.order = append([]string(nil), rs.order...)
+	c.steps = make(map[string]*step, len(rs.steps))
+	for name, s := range rs.steps {
+		cs := *s
+		cs.endWords = append([]string(nil), s.endWords...)
+		cs.rules = make([]rule, len(s.rules))
+		for i, r := range s.rules {
+			r.exceptions = append([]string(nil), r.exceptions...)
+			cs.rules[i] = r
+		}
+		c.steps[name] = &cs
+	}
+	return &c
+}
+
+// rulePosition is the position of a rule in its rule set.
+type rulePosition struct {
+	step  string
+	index int
+}
+
+// positions returns the position of each rule of the rule set.
+func (rs *RuleSet) positions() map[*rule]rulePosition {
+	positions := map[*rule]rulePosition{}
+	for name, s := range rs.steps {
+		for i := range s.rules {
+			positions[&s.rules[i]] = rulePosition{name, i}
+		}
+	}
+	return positions
+}
+
+// Apply returns a copy of the rule set with the suggestions applied. The
+// rule set itself is not changed.
+func (rs *RuleSet) Apply(suggestions ...Suggestion) (*RuleSet, error) {
+	c := rs.clone()
+	for _, sg := range suggestions {
+		s := c.steps[sg.Step]
+		if s == nil || sg.Rule < 0 || sg.Rule >= len(s.rules) || s.rules[sg.Rule].suffix != sg.Suffix {
+			return nil, fmt.Errorf("rslp: no rule %s/%d with suffix %q", sg.Step, sg.Rule, sg.Suffix)
+		}
+		r := &s.rules[sg.Rule]
+		if sg.Exception != "" {
+			r.exceptions = append(r.exceptions, sg.Exception)
+		} else {
+			r.minLength = sg.MinLength
+		}
+	}
+	return c, nil
+}
+
+// correct reports which of the pairs the options stem as labeled.
+func correct(o Options, pairs []Pair) []bool {
+	ok := make([]bool, len(pairs))
+	for i, p := range pairs {
+		ok[i] = (o.Stem(p.A) == o.Stem(p.B)) == p.Conflate
+	}
+	return ok
+}
+
+// Suggest finds the rules responsible for the pairs the options stem
+// wrongly, and returns the changes to those rules that fix more pairs than
+// they break, from the largest to the smallest net improvement, exceptions
+// first.
+//
+// For each rule applied to a word of a wrong pair, it proposes an exception
+// for the word as the rule saw it, and the minimum length that keeps the
+// rule from applying to it. For each rule that was too short to apply, it
+// proposes the minimum length that lets it apply. Each change is evaluated
+// on all the pairs.
+func Suggest(o Options, pairs []Pair) []Suggestion {
+	rs := o.rules()
+
+	// the position of each rule, to compare with the rules of the copies.
+	positions := rs.positions()
+
+	seen := map[Suggestion]bool{}
+	var candidates []Suggestion
+	add := func(s Suggestion) {
+		if !seen[s] {
+			seen[s] = true
+			candidates = append(candidates, s)
+		}
+	}
+
+	trace := func(step string, r *rule, event ruleEvent, before, after string) {
+		pos := positions[r]
+		sg := Suggestion{Step: pos.step, Rule: pos.index, Suffix: r.suffix}
+
+		n, s := len(before), len(r.suffix)
+		if rs.lucene {
+			n, s = utf8.RuneCountInString(before), utf8.RuneCountInString(r.suffix)
+		}
+		switch event {
+		case ruleApplied:
+			e := sg
+			e.Exception = before
+			add(e)
+			sg.MinLength = n - s + 1
+			add(sg)
+		case ruleLength:
+			if n > s {
+				sg.MinLength = n - s
+				add(sg)
+			}
+		}
+	}
+
+	before := correct(o, pairs)
+	for i, p := range pairs {
+		if !before[i] {
+			o.stem(strings.ToValidUTF8(p.A, string(utf8.RuneError)), trace)
+			o.stem(strings.ToValidUTF8(p.B, string(utf8.RuneError)), trace)
+		}
+	}
+
+	var suggestions []Suggestion
+	for _, sg := range candidates {
+		changed, err := rs.Apply(sg)
+		if err != nil {
+			continue
+		}
+		co := o
+		co.Rules = changed
+		for i, ok := range correct(co, pairs) {
+			if ok && !before[i] {
+				sg.Fixed++
+			} else if !ok && before[i] {
+				sg.Broken++
+			}
+		}
+		if sg.Net() > 0 {
+			suggestions = append(suggestions, sg)
+		}
+	}
+
+	index := make(map[string]int, len(rs.order))
+	for i, name := range rs.order {
+		index[name] = i
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		switch {
+		case a.Net() != b.Net():
+			return a.Net() > b.Net()
+		case a.Broken != b.Broken:
+			return a.Broken < b.Broken
+		case (a.Exception == "") != (b.Exception == ""):
+			// an exception changes fewer words than a min length.
+			return a.Exception != ""
+		case a.Step != b.Step:
+			return index[a.Step] < index[b.Step]
+		case a.Rule != b.Rule:
+			return a.Rule < b.Rule
+		case a.Exception != b.Exception:
+			return a.Exception < b.Exception
+		}
+		return a.MinLength < b.MinLength
+	})
+	return suggestions
+}
+
+// Improve applies the best suggestion for the pairs to the rule set of the
+// options, as long as there is one, and returns the resulting rule set with
+// the applied suggestions in order.
+func Improve(o Options, pairs []Pair) (*RuleSet, []Suggestion) {
+	rs := o.rules()
+
+	var applied []Suggestion
+	for {
+		o.Rules = rs
+		suggestions := Suggest(o, pairs)
+		if len(suggestions) == 0 {
+			return rs, applied
+		}
+		// each suggestion fixes more pairs than it breaks, so this terminates.
+		next, err := rs.Apply(suggestions[0])
+		if err != nil {
+			return rs, applied
+		}
+		rs = next
+		applied = append(applied, suggestions[0])
+	}
+}
@@ -0,0 +1,165 @@
+package rslp
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const suggestRules = `
+{ "Plural", 3, 1, {"s"},
+{"s",2,"",{"lápis"}}};
+
+{ "Feminine", 3, 0, {"a"},
+{"ona",3,"ão"}};
+`
+
+const suggestPairs = `
+# over-stemmed
+pelos pelo !=
+gatos gato
+
+# under-stemmed
+leona leão =
+dona dão
+`
+
+func TestReadPairs(t *testing.T) {
+	pairs, err := ReadPairs(strings.NewReader(suggestPairs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Pair{
+		{"pelos", "pelo", false},
+		{"gatos", "gato", true},
+		{"leona", "leão", true},
+		{"dona", "dão", true},
+	}
+	if !reflect.DeepEqual(pairs, want) {
+		t.Fatalf("invalid pairs\nwant %v\n got %v", want, pairs)
+	}
+
+	for _, input := range []string{"casa", "casa casas ~", "a b c ="} {
+		if _, err := ReadPairs(strings.NewReader(input)); err == nil {
+			t.Fatalf("expected an error for %q", input)
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	rs, err := LoadRules("test", strings.NewReader(suggestRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pairs, err := ReadPairs(strings.NewReader(suggestPairs))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a min length of 5 for -s also fixes pelos, but breaks gatos.
+	want := []Suggestion{
+		{Step: "Feminine", Rule: 0, Suffix: "ona", MinLength: 1, Fixed: 2},
+		{Step: "Plural", Rule: 0, Suffix: "s", Exception: "pelos", Fixed: 1},
+		{Step: "Feminine", Rule: 0, Suffix: "ona", MinLength: 2, Fixed: 1},
+	}
+	if got := Suggest(Options{Rules: rs}, pairs); !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid suggestions\nwant %v\n got %v", want, got)
+	}
+}
+
+func TestImprove(t *testing.T) {
+	rs, err := LoadRules("test", strings.NewReader(suggestRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pairs, err := ReadPairs(strings.NewReader(suggestPairs))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	improved, applied := Improve(Options{Rules: rs}, pairs)
+	want := []Suggestion{
+		{Step: "Feminine", Rule: 0, Suffix: "ona", MinLength: 1, Fixed: 2},
+		{Step: "Plural", Rule: 0, Suffix: "s", Exception: "pelos", Fixed: 1},
+	}
+	if !reflect.DeepEqual(applied, want) {
+		t.Fatalf("invalid applied suggestions\nwant %v\n got %v", want, applied)
+	}
+	for _, ok := range correct(Options{Rules: improved}, pairs) {
+		if !ok {
+			t.Fatal("the improved rule set stems a pair wrongly")
+		}
+	}
+
+	// the original rule set is unchanged.
+	if got := (Options{Rules: rs}).Stem("pelos"); got != "pelo" {
+		t.Fatalf("the original rule set changed, pelos -> %q", got)
+	}
+}
+
+func TestImproveRoundTrip(t *testing.T) {
+	pairs, err := ReadPairs(strings.NewReader(`
+irmãs irmão
+computadores computar !=
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	improved, applied := Improve(Options{Rules: LucenePortuguese}, pairs)
+	if len(applied) == 0 {
+		t.Fatal("no suggestion applied")
+	}
+
+	var buf bytes.Buffer
+	if err := improved.WriteRules(&buf); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadRules("improved", &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Fingerprint() != improved.Fingerprint() {
+		t.Fatal("the reloaded rule set has another fingerprint")
+	}
+
+	for _, ok := range correct(Options{Rules: loaded}, pairs) {
+		if !ok {
+			t.Fatal("the reloaded rule set stems a pair wrongly")
+		}
+	}
+	// words stemmed differently with byte lengths or without the flow of
+	// Lucene.
+	for _, word := range []string{"dá", "pães", "adequadamente", "cantárei", "balões"} {
+		if got, want := (Options{Rules: loaded}).Stem(word), (Options{Rules: improved}).Stem(word); got != want {
+			t.Fatalf("invalid stem output, %q -> %q (got %q)", word, want, got)
+		}
+	}
+}
+
+func TestApplyErrors(t *testing.T) {
+	for _, s := range []Suggestion{
+		{Step: "Missing", Suffix: "s"},
+		{Step: "Plural", Rule: 99, Suffix: "s"},
+		{Step: "Plural", Rule: 0, Suffix: "x"},
+	} {
+		if _, err := Portuguese.Apply(s); err == nil {
+			t.Fatalf("expected an error for %v", s)
+		}
+	}
+}
+
+func TestSuggestionString(t *testing.T) {
+	for _, tt := range []struct {
+		s    Suggestion
+		want string
+	}{
+		{Suggestion{Step: "Plural", Rule: 10, Suffix: "s", Exception: "pelos", Fixed: 3, Broken: 1}, `Plural/10 (-s): add exception "pelos" (fixes 3, breaks 1)`},
+		{Suggestion{Step: "Feminine", Suffix: "ona", MinLength: 2, Fixed: 1}, `Feminine/0 (-ona): set min length to 2 (fixes 1, breaks 0)`},
+	} {
+		if got := tt.s.String(); got != tt.want {
+			t.Fatalf("invalid string, want %q (got %q)", tt.want, got)
+		}
+	}
+}
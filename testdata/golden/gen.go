@@ -0,0 +1,256 @@
+//go:build ignore
+// +build ignore
+
+// This program generates vocabulary.tsv, the golden vocabulary used by
+// TestGolden. It inflects regular Portuguese verbs, nouns and adjectives
+// and records the stem of every word produced by the current rules:
+//
+//	go run testdata/golden/gen.go > testdata/golden/vocabulary.tsv
+//
+// Regenerate the file only when a change to the rules is intentional.
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/knuppe/rslp"
+)
+
+// Regular verbs whose stems do not change their spelling when inflected.
+var (
+	arVerbs = `
+acabar aceitar achar acompanhar acordar acusar adiantar administrar admirar adorar afastar
+afirmar agitar agradar ajudar ajustar alimentar alterar amar amarrar analisar andar animar
+anunciar apertar apoiar apontar apostar apresentar aprovar aproveitar arrumar assinar assustar
+atirar atrasar aumentar autorizar avaliar avisar baixar batalhar beijar brilhar calar
+calcular caminhar cansar cantar casar cavar celebrar chamar cheirar chorar chutar circular
+citar cobrar cochilar colaborar colar combinar comemorar comentar completar comprar confirmar
+conquistar conservar considerar consultar contar contratar controlar conversar cooperar copiar
+cortar costurar criar cuidar cumprimentar declarar decorar deitar deixar demonstrar denunciar
+depositar derrotar derrubar desabafar descansar desejar desenhar despertar determinar disputar
+doar dominar durar economizar editar elogiar eliminar emocionar emprestar empurrar encontrar
+encostar enfrentar enganar ensinar enterrar entrar enviar errar escapar escovar escutar
+espalhar esperar esquentar estimar estudar evitar exagerar explorar exportar expressar expulsar
+falar falhar faltar fechar festejar filmar filtrar firmar fixar formar frequentar frustrar
+fumar funcionar furar ganhar gastar gelar gerar girar gostar governar gravar gritar grudar
+guardar habitar herdar hesitar honrar ignorar iluminar imaginar importar inaugurar incomodar
+informar iniciar inspirar instalar interessar inventar irritar isolar jantar juntar lamentar
+lavar lembrar levantar levar libertar limitar limpar listar lotar lutar mamar manchar mandar
+manifestar matar meditar melhorar mencionar mergulhar misturar molhar montar morar mostrar
+mudar nadar namorar narrar necessitar negociar notar observar ocultar ocupar olhar operar
+orar ordenar organizar ousar parar participar passar penetrar pensar perdoar perguntar
+perturbar pesar pesquisar pilotar pintar pisar planejar plantar pousar precisar premiar
+preparar prestar proclamar procurar projetar provar pular quebrar queimar raspar realizar
+reclamar recordar recuperar recusar reformar registrar regressar relatar relaxar remar
+reparar representar reservar respeitar respirar restar resultar retirar revelar rezar roubar
+saltar salvar sangrar saudar segurar selar sentar separar simular soltar somar sonhar soprar
+suar sujar suportar suspirar sustentar tardar telefonar tentar terminar testar tirar tomar
+tombar tornar trabalhar transformar transportar tratar ultrapassar usar utilizar vaiar validar
+variar viajar vibrar vigiar virar visitar voltar votar zelar zombar
+`
+	erVerbs = `
+absorver acender aprender atender bater beber comer cometer compreender conceder correr
+corromper debater defender depender derreter dever devolver empreender entender envolver
+escolher esconder escorrer estender lamber meter mexer morder ocorrer ofender percorrer
+pretender proceder promover rebater receber recorrer remexer render resolver responder romper
+socorrer sofrer sorver suceder surpreender suspender temer tremer varrer vender
+`
+	irVerbs = `
+admitir aplaudir assistir assumir coincidir colidir comprimir confundir consumir cumprir
+curtir decidir definir demitir desistir difundir discutir dividir emitir exibir existir
+expandir explodir exprimir fundir garantir iludir imprimir incidir inibir insistir invadir
+nutrir omitir partir permitir persistir presidir presumir reprimir residir resistir resumir
+suprimir transmitir unir
+`
+)
+
+// Inflectional endings of the regular conjugations, as in the infinitive,
+// gerund, participle and the simple tenses, including the second person
+// plural (vós) forms.
+var (
+	arEndings = `
+ar ando ado ada ados adas
+o as a amos ais am
+ava avas ávamos áveis avam
+ei aste ou astes aram
+ara aras áramos áreis
+arei arás ará aremos areis arão
+aria arias aríamos aríeis ariam
+e es emos eis em
+asse asses ássemos ásseis assem
+ares armos ardes arem
+`
+	erEndings = `
+er endo ido ida idos idas
+o es e emos eis em
+ia ias íamos íeis iam
+i este eu estes eram
+era eras êramos êreis
+erei erás erá eremos ereis erão
+eria erias eríamos eríeis eriam
+a as amos ais am
+esse esses êssemos êsseis essem
+eres ermos erdes erem
+`
+	irEndings = `
+ir indo ido ida idos idas
+o es e imos is em
+ia ias íamos íeis iam
+i iste iu istes iram
+ira iras íramos íreis
+irei irás irá iremos ireis irão
+iria irias iríamos iríeis iriam
+a as amos ais am
+isse isses íssemos ísseis issem
+ires irmos irdes irem
+`
+)
+
+// Adjectives ending in "o" inflected in gender, number, degree and as
+// adverbs.
+var adjectives = `
+aberto absoluto abstrato adequado alto amarelo amargo animado antigo apaixonado assustado
+atento azedo baixo barato bonito branco bravo calmo cansado caro certo cheio claro complicado
+completo concreto correto curioso cuidadoso delicado denso direto duro educado elevado
+envergonhado errado escasso escuro estreito exato extenso famoso feio fino generoso gordo
+gostoso honesto imenso intenso justo lento ligeiro limpo lindo maduro magro moderado moderno
+molhado negro nervoso novo obscuro obrigado ocupado perfeito perigoso pequeno preguiçoso
+preocupado preto profundo puro quieto raro redondo reservado roxo seguro sereno severo sincero
+solteiro sujo tenso tranquilo velho vermelho
+`
+
+// Nouns inflected in number and with their diminutive.
+var (
+	oNouns = `
+ano banho burro cabelo campo carro casamento cavalo coelho copo corpo dedo documento filho
+gato joelho livro lobo medicamento menino momento movimento mundo olho ombro ovo pato
+pensamento povo prato quarto queixo rato rosto sapato sentimento touro urso vestido
+apartamento tratamento conhecimento crescimento nascimento julgamento regulamento
+`
+	aNouns = `
+abelha amiga areia banana batata bolsa borboleta cabra cadeira camisa caneta carta casa
+coisa conta escola estrela festa filha floresta formiga fruta galinha garrafa gata hora
+igreja janela laranja lista lua menina mesa montanha ovelha palavra panela pedra pessoa
+porta praia prima roupa rua saia semana tarefa tela terra tia vaca vida
+`
+	caoNouns = `
+canção nação estação lição função relação situação educação informação organização
+população operação solução posição condição produção atenção intenção
+`
+	dadeNouns = `
+cidade verdade vontade saudade liberdade sociedade universidade realidade qualidade
+quantidade atividade possibilidade necessidade capacidade felicidade identidade comunidade
+autoridade oportunidade dificuldade velocidade idade amizade
+`
+	alNouns = `
+normal final animal hospital jornal canal geral especial social natural nacional local
+total legal real central moral mental anual igual ideal formal principal tropical musical
+policial digital federal
+`
+	velNouns = `
+amável agradável confortável responsável possível terrível horrível incrível visível
+sensível provável miserável
+`
+	orNouns = `
+professor doutor senhor ator autor escritor pintor cantor jogador trabalhador vendedor
+leitor computador elevador ventilador amor calor valor
+`
+	orFeminine = `
+professor cantor pintor jogador trabalhador vendedor leitor escritor autor
+`
+	eiroNouns = `
+brasileiro padeiro carpinteiro cozinheiro engenheiro bombeiro jardineiro banheiro dinheiro
+primeiro inteiro verdadeiro companheiro estrangeiro pedreiro marinheiro
+`
+	istaNouns = `
+artista dentista jornalista turista motorista especialista pianista socialista realista
+`
+	ismoNouns = `
+turismo jornalismo realismo socialismo capitalismo racismo egoísmo otimismo pessimismo
+`
+)
+
+func main() {
+	words := map[string]bool{}
+	add := func(w ...string) {
+		for _, word := range w {
+			words[word] = true
+		}
+	}
+
+	conjugate := func(verbs, endings string) {
+		for _, verb := range strings.Fields(verbs) {
+			stem := verb[:len(verb)-2]
+			for _, e := range strings.Fields(endings) {
+				add(stem + e)
+			}
+		}
+	}
+	conjugate(arVerbs, arEndings)
+	conjugate(erVerbs, erEndings)
+	conjugate(irVerbs, irEndings)
+
+	for _, adj := range strings.Fields(adjectives) {
+		base := strings.TrimSuffix(adj, "o")
+		add(adj, base+"a", base+"os", base+"as", base+"amente")
+		if !strings.HasSuffix(base, "c") && !strings.HasSuffix(base, "g") {
+			add(base+"inho", base+"inha", base+"íssimo", base+"íssima")
+		}
+	}
+
+	for _, noun := range strings.Fields(oNouns) {
+		base := strings.TrimSuffix(noun, "o")
+		add(noun, noun+"s", base+"inho", base+"inhos", base+"ão")
+	}
+	for _, noun := range strings.Fields(aNouns) {
+		base := strings.TrimSuffix(noun, "a")
+		add(noun, noun+"s", base+"inha", base+"inhas")
+	}
+	for _, noun := range strings.Fields(caoNouns) {
+		add(noun, strings.TrimSuffix(noun, "ão")+"ões")
+	}
+	for _, noun := range strings.Fields(dadeNouns) {
+		add(noun, noun+"s")
+	}
+	for _, noun := range strings.Fields(alNouns) {
+		add(noun, strings.TrimSuffix(noun, "l")+"is")
+	}
+	for _, noun := range strings.Fields(velNouns) {
+		adverb := strings.NewReplacer("á", "a", "í", "i").Replace(noun) + "mente"
+		add(noun, strings.TrimSuffix(noun, "el")+"eis", adverb)
+	}
+	for _, noun := range strings.Fields(orNouns) {
+		add(noun, noun+"es")
+	}
+	for _, noun := range strings.Fields(orFeminine) {
+		add(noun+"a", noun+"as")
+	}
+	for _, noun := range strings.Fields(eiroNouns) {
+		base := strings.TrimSuffix(noun, "o")
+		add(noun, base+"a", base+"os", base+"as")
+	}
+	for _, noun := range strings.Fields(istaNouns) {
+		add(noun, noun+"s")
+	}
+	for _, noun := range strings.Fields(ismoNouns) {
+		add(noun, noun+"s")
+	}
+
+	sorted := make([]string, 0, len(words))
+	for word := range words {
+		sorted = append(sorted, word)
+	}
+	sort.Strings(sorted)
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "# word\tstem")
+	for _, word := range sorted {
+		fmt.Fprintf(w, "%s\t%s\n", word, rslp.Stem(word))
+	}
+}
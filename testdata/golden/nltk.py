@@ -0,0 +1,20 @@
+#!/usr/bin/env python3
+"""Writes nltk.tsv, the stems of the golden vocabulary produced by NLTK's
+RSLPStemmer, which TestGolden then compares with this package:
+
+    python3 -c 'import nltk; nltk.download("rslp")'
+    python3 testdata/golden/nltk.py < testdata/golden/vocabulary.tsv > testdata/golden/nltk.tsv
+"""
+import sys
+
+from nltk.stem import RSLPStemmer
+
+stemmer = RSLPStemmer()
+
+print("# word\tstem")
+for line in sys.stdin:
+    if line.startswith("#"):
+        continue
+    word = line.split("\t")[0].strip()
+    if word:
+        print(f"{word}\t{stemmer.stem(word)}")
@@ -0,0 +1,38 @@
+// Writes expected.tsv, the stems of the words of snapshot.tsv produced by
+// Lucene's PortugueseStemmer, which TestLucenePortugueseExpected then
+// compares with rslp.LucenePortuguese:
+//
+//     javac -cp lucene-core.jar:lucene-analysis-common.jar Expected.java
+//     java -cp .:lucene-core.jar:lucene-analysis-common.jar Expected < snapshot.tsv > expected.tsv
+//
+// Before Lucene 9 the stemmer lives in lucene-analyzers-common.jar.
+
+import java.io.BufferedReader;
+import java.io.IOException;
+import java.io.InputStreamReader;
+import java.io.PrintStream;
+import java.nio.charset.StandardCharsets;
+import java.util.Arrays;
+
+import org.apache.lucene.analysis.pt.PortugueseStemmer;
+import org.apache.lucene.util.Version;
+
+public class Expected {
+  public static void main(String[] args) throws IOException {
+    BufferedReader in = new BufferedReader(new InputStreamReader(System.in, StandardCharsets.UTF_8));
+    PrintStream out = new PrintStream(System.out, true, "UTF-8");
+    PortugueseStemmer stemmer = new PortugueseStemmer();
+
+    out.println("# Stems of Lucene " + Version.LATEST + "'s PortugueseStemmer, written by Expected.java.");
+    for (String line; (line = in.readLine()) != null; ) {
+      if (line.isEmpty() || line.startsWith("#")) {
+        continue;
+      }
+      String word = line.split("\t")[0];
+      // the rules may grow the word by a few characters
+      char[] buf = Arrays.copyOf(word.toCharArray(), word.length() + 16);
+      int len = stemmer.stem(buf, word.length());
+      out.println(word + "\t" + new String(buf, 0, len));
+    }
+  }
+}
@@ -0,0 +1,103 @@
+package rslp
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Token is a word of a sentence along with its stem.
+type Token struct {
+	// Text is the word as written in the sentence, or the component of a
+	// contraction when they are expanded. The filters of an Analyzer
+	// replace it by the analyzed term.
+	Text string `json:"text"`
+
+	// Stem is the stem of the word.
+	Stem string `json:"stem"`
+
+	// Start and End are the byte offsets of the word in the sentence. The
+	// components of a contraction share the offsets of the contraction.
+	Start int `json:"start"`
+	End   int `json:"end"`
+
+	// Position is the index of the token in the sentence, counting the
+	// stopwords, so that phrases keep their gaps when these are dropped.
+	Position int `json:"position"`
+
+	// Stopword reports whether the word is one of the stopwords of the
+	// options.
+	Stopword bool `json:"stopword,omitempty"`
+
+	// Keyword reports whether the word is protected from stemming by the
+	// KeywordFilter of an Analyzer.
+	Keyword bool `json:"keyword,omitempty"`
+}
+
+// Tokens splits a sentence into its words, separated by white space, and
+// stems them using the options. Stopwords are flagged but not dropped.
+func (o Options) Tokens(sentence string) []Token {
+	tokens := tokenize(sentence)
+	if o.ExpandContractions {
+		tokens = expandTokens(tokens)
+	}
+	for i, t := range tokens {
+		tokens[i].Stem = o.Stem(t.Text)
+		tokens[i].Stopword = o.Stopwords != nil && o.Stopwords.Contains(t.Text)
+	}
+	return tokens
+}
+
+// tokenize splits a sentence into its words, separated by white space.
+func tokenize(sentence string) []Token {
+	var tokens []Token
+	for _, span := range wordSpans(sentence) {
+		tokens = append(tokens, Token{
+			Text:     sentence[span[0]:span[1]],
+			Start:    span[0],
+			End:      span[1],
+			Position: len(tokens),
+		})
+	}
+	return tokens
+}
+
+// expandTokens replaces the contractions by their components, shifting the
+// positions of the following tokens.
+func expandTokens(tokens []Token) []Token {
+	expanded := make([]Token, 0, len(tokens))
+	shift := 0
+	for _, t := range tokens {
+		parts := expandContraction(t.Text)
+		for i, p := range parts {
+			e := t
+			e.Text = p
+			e.Position += shift + i
+			expanded = append(expanded, e)
+		}
+		shift += len(parts) - 1
+	}
+	return expanded
+}
+
+// wordSpans returns the byte offsets of the start and end of the words of
+// the sentence, as split by strings.Fields.
+func wordSpans(sentence string) [][2]int {
+	var spans [][2]int
+	start := -1
+	for i := 0; i < len(sentence); {
+		r, n := utf8.DecodeRuneInString(sentence[i:])
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				spans = append(spans, [2]int{start, i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+		i += n
+	}
+	if start >= 0 {
+		spans = append(spans, [2]int{start, len(sentence)})
+	}
+	return spans
+}
@@ -0,0 +1,33 @@
+package rslp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokens(t *testing.T) {
+	opts := Options{Stopwords: BrazilianStopwords, ExpandContractions: true}
+	got := opts.Tokens("Meninas  do\tBrasil")
+	want := []Token{
+		{Text: "Meninas", Stem: "menin", Start: 0, End: 7, Position: 0},
+		{Text: "de", Stem: "de", Start: 9, End: 11, Position: 1, Stopword: true},
+		{Text: "o", Stem: "o", Start: 9, End: 11, Position: 2, Stopword: true},
+		{Text: "Brasil", Stem: "brasil", Start: 12, End: 18, Position: 3},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid tokens\nwant %+v\n got %+v", want, got)
+	}
+}
+
+func TestTokensOffsets(t *testing.T) {
+	sentence := " pães e  canções "
+	for _, tok := range (Options{}).Tokens(sentence) {
+		if sentence[tok.Start:tok.End] != tok.Text {
+			t.Fatalf("invalid offsets of %q: %d-%d", tok.Text, tok.Start, tok.End)
+		}
+	}
+	if n := len((Options{}).Tokens(sentence)); n != 3 {
+		t.Fatalf("invalid number of tokens, want 3 (got %d)", n)
+	}
+}
@@ -0,0 +1,177 @@
+package rslp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/transform"
+)
+
+// Version returns the version of the rule set, or an empty string if it has
+// none. The versions of the built-in rule sets are increased whenever a
+// change to them changes their stems.
+func (rs *RuleSet) Version() string {
+	return rs.version
+}
+
+// foldProbe holds the letters with diacritics of Latin-1 and Latin
+// Extended-A, both cases, whose folding fingerprints the removal of the
+// diacritics of a rule set.
+var foldProbe = func() string {
+	var b strings.Builder
+	for r := rune(0xc0); r <= 0x17f; r++ {
+		b.WriteRune(r)
+	}
+	return b.String()
+}()
+
+// fingerprintWriter writes length-prefixed fields to a hash, so that no two
+// sequences of fields write the same bytes.
+type fingerprintWriter struct {
+	h hash.Hash
+}
+
+func (f fingerprintWriter) strings(fields ...string) {
+	fmt.Fprintf(f.h, "%d;", len(fields))
+	for _, s := range fields {
+		fmt.Fprintf(f.h, "%d:%s", len(s), s)
+	}
+}
+
+func (f fingerprintWriter) ints(fields ...int) {
+	for _, n := range fields {
+		fmt.Fprintf(f.h, "%d;", n)
+	}
+}
+
+// pairs writes the keys of the map, sorted, each followed by its value.
+func (f fingerprintWriter) pairs(m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	f.ints(len(keys))
+	for _, k := range sorted(keys) {
+		f.strings(k, m[k])
+	}
+}
+
+// set writes the words of the set, sorted.
+func (f fingerprintWriter) set(m map[string]bool) {
+	words := make([]string, 0, len(m))
+	for w := range m {
+		words = append(words, w)
+	}
+	f.strings(sorted(words)...)
+}
+
+func (f fingerprintWriter) bools(fields ...bool) {
+	for _, b := range fields {
+		if b {
+			f.h.Write([]byte{1})
+		} else {
+			f.h.Write([]byte{0})
+		}
+	}
+}
+
+func (f fingerprintWriter) sum() string {
+	return hex.EncodeToString(f.h.Sum(nil))
+}
+
+// sorted returns a sorted copy of the list.
+func sorted(list []string) []string {
+	list = append([]string(nil), list...)
+	sort.Strings(list)
+	return list
+}
+
+// write writes the content of the rule set that changes its stems: the
+// steps and their rules, the flow between the steps, whether it has the
+// semantics of Lucene and how it removes the diacritics.
+func (rs *RuleSet) write(f fingerprintWriter) {
+	f.strings("rslp", rs.start)
+	f.ints(maxStepRuns)
+	f.bools(rs.lucene)
+
+	names := sorted(rs.order)
+	f.ints(len(names))
+	for _, name := range names {
+		s := rs.steps[name]
+		f.strings(name, s.stepPass, s.stepFail)
+		f.ints(s.minLength)
+		f.bools(s.entireWord)
+		f.strings(sorted(s.endWords)...)
+		f.ints(len(s.rules))
+		for _, r := range s.rules {
+			f.strings(r.suffix, r.replacement)
+			f.ints(r.minLength)
+			f.strings(sorted(r.exceptions)...)
+		}
+	}
+
+	folded, _, err := transform.String(folds[rs.fold], foldProbe)
+	if err != nil {
+		folded = err.Error()
+	}
+	f.strings(folded)
+}
+
+// Fingerprint returns a hash of the content of the rule set that changes its
+// stems: the steps and their rules, with their exceptions, the flow between
+// the steps and the normalization of the words. It doesn't depend on the
+// name or version of the rule set, nor on the order of the steps or of the
+// exceptions in a rule file. Stems stored along with the fingerprint of the
+// rule set, such as in a search index, are still valid for a rule set with
+// the same fingerprint.
+func (rs *RuleSet) Fingerprint() string {
+	f := fingerprintWriter{sha256.New()}
+	rs.write(f)
+	return f.sum()
+}
+
+// Fingerprint returns a hash of the rule set of the options, as returned by
+// RuleSet.Fingerprint, and of the options themselves, including the words
+// of the stopword list and the tables used by the Orthography, DetachClitics
+// and ExpandContractions options when they are set.
+func (o Options) Fingerprint() string {
+	f := fingerprintWriter{sha256.New()}
+	o.rules().write(f)
+	f.bools(o.KeepDiacritics, o.PreserveCase, o.Orthography, o.DetachClitics, o.ExpandContractions, o.Stopwords != nil)
+	if o.Stopwords != nil {
+		f.set(o.Stopwords.words)
+	}
+	if o.Orthography {
+		f.ints(len(orthographyRoots))
+		for _, r := range orthographyRoots {
+			f.strings(r.old, r.new)
+		}
+		f.pairs(orthographyWords)
+		f.strings(sorted(orthographyExceptions)...)
+	}
+	if o.DetachClitics {
+		f.set(cliticPronouns)
+		f.set(mesoclisisEndings)
+		f.ints(len(cliticInfinitives))
+		for _, r := range cliticInfinitives {
+			f.strings(r.suffix, r.replacement)
+		}
+		f.pairs(cliticIrregulars)
+	}
+	if o.ExpandContractions {
+		words := make([]string, 0, len(contractions))
+		for w := range contractions {
+			words = append(words, w)
+		}
+		f.ints(len(words))
+		for _, w := range sorted(words) {
+			f.strings(w)
+			f.strings(contractions[w]...)
+		}
+	}
+	return f.sum()
+}
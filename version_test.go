@@ -0,0 +1,192 @@
+package rslp
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestBuiltinFingerprints pins the fingerprints of the built-in rule sets: a
+// change to their stems must increase their version along with the
+// fingerprint here.
+func TestBuiltinFingerprints(t *testing.T) {
+	for _, tt := range []struct {
+		rs          *RuleSet
+		version     string
+		fingerprint string
+	}{
+		{Portuguese, "1", "611f84c9641375b5f0ae4f1730a8c0610c99cf23e3d524acca360def14b7d977"},
+		{PortugueseExtended, "1", "6717478419c5c6974727c0ef034f2fc2b0cc8145a32f218b6a24e3c2c287dc3f"},
+		{LucenePortuguese, "1", "6beaff2fb2b72fb61fb1c7403df254c6cfb0a7e80e9af4424251d34037f43dc2"},
+		{Galician, "1", "dcb78f4ab5fa55147d6851b7c89f2705bb00c097f1e5ef73d3ca247b9008e58c"},
+	} {
+		if got := tt.rs.Version(); got != tt.version {
+			t.Errorf("%s: invalid version, want %q (got %q)", tt.rs.Name(), tt.version, got)
+		}
+		if got := tt.rs.Fingerprint(); got != tt.fingerprint {
+			t.Errorf("%s: invalid fingerprint, want %q (got %q)", tt.rs.Name(), tt.fingerprint, got)
+		}
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	rs, err := LoadRules("test", strings.NewReader(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the same rules in another order and with another name.
+	reordered, err := LoadRules("other", strings.NewReader(`
+# version: 2
+{ "Plural", "Feminine", "Feminine" },
+{ "Feminine", "", "Vowel" },
+{ "Vowel", "", "" };
+
+{ "Vowel", 0, 1, {},
+{"o",3}};
+
+{ "Feminine", 3, 0, {"a"},
+{"ona", 3, "ão"},
+{"eira",3,"eiro",{"beira"}}};
+
+{ "Plural", 3, 1, {"s"},
+{"ns",1,"m"},
+{"s",2,"",{"mais","lápis"}}};
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reordered.Version() != "2" {
+		t.Fatalf("invalid version %q", reordered.Version())
+	}
+	if rs.Fingerprint() != reordered.Fingerprint() {
+		t.Fatal("the fingerprint depends on the order of the rule file")
+	}
+
+	changed, err := rs.Apply(Suggestion{Step: "Plural", Rule: 1, Suffix: "s", Exception: "pelos"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs.Fingerprint() == changed.Fingerprint() {
+		t.Fatal("the fingerprint doesn't depend on the exceptions")
+	}
+
+	lucene := rs.clone()
+	lucene.lucene = true
+	folded := rs.clone()
+	folded.fold = "lucene"
+	flow := rs.clone()
+	flow.steps["Feminine"].stepPass = "Vowel"
+	for _, other := range []*RuleSet{lucene, folded, flow} {
+		if rs.Fingerprint() == other.Fingerprint() {
+			t.Fatal("the fingerprint doesn't depend on the normalization or the flow")
+		}
+	}
+
+	if Portuguese.Compiled(func(word string) string { return word }).Fingerprint() != Portuguese.Fingerprint() {
+		t.Fatal("the fingerprint depends on the compiled steps")
+	}
+}
+
+func TestFingerprintRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Portuguese.WriteRules(&buf); err != nil {
+		t.Fatal(err)
+	}
+	rs, err := LoadRules("copy", &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs.Version() != Portuguese.Version() || rs.Fingerprint() != Portuguese.Fingerprint() {
+		t.Fatalf("the rule file changed the version %q or the fingerprint", rs.Version())
+	}
+
+	for _, name := range []string{"test.yaml", "test.json"} {
+		if rs := loadStructured(t, name); rs.Version() != "" {
+			t.Fatalf("%s: unexpected version %q", name, rs.Version())
+		}
+	}
+	rs, err = LoadRulesYAML("test", strings.NewReader("version: \"3\"\nsteps:\n  - name: A\n    rules:\n      - suffix: s\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs.Version() != "3" {
+		t.Fatalf("invalid version %q", rs.Version())
+	}
+}
+
+func TestOptionsFingerprint(t *testing.T) {
+	base := Options{}.Fingerprint()
+	if base != (Options{Rules: Portuguese}).Fingerprint() {
+		t.Fatal("the default rule set changed the fingerprint")
+	}
+	if base == Portuguese.Fingerprint() {
+		t.Fatal("the options fingerprint is the rule set fingerprint")
+	}
+
+	seen := map[string]bool{base: true}
+	for _, o := range []Options{
+		{KeepDiacritics: true},
+		{PreserveCase: true},
+		{Orthography: true},
+		{DetachClitics: true},
+		{ExpandContractions: true},
+		{Stopwords: BrazilianStopwords},
+		{Stopwords: EuropeanStopwords},
+		{Rules: LucenePortuguese},
+	} {
+		f := o.Fingerprint()
+		if seen[f] {
+			t.Fatalf("duplicate fingerprint for %+v", o)
+		}
+		seen[f] = true
+	}
+
+	// the tables of the options are pinned: a change to them changes the
+	// stems, and must change the fingerprints here.
+	for _, tt := range []struct {
+		opts        Options
+		fingerprint string
+	}{
+		{Options{}, "1443382e32f70008cacf6861bbd9a51c1a9a521906fd2c1d24231f669b5b3791"},
+		{Options{Orthography: true}, "9cc2de9c5a1c2c9ecf510202c12171c538f0c2c4042414f1452c73113bb3dd67"},
+		{Options{DetachClitics: true}, "00756597b2746c1e08efeb1bb832c95f45bb745d8ba313d4f6d6607ffbbb7e16"},
+		{Options{ExpandContractions: true}, "f0f5e9912fc16fe590425b28ee3ef835f49e7b261f0e6eab8d75ae89ed41010d"},
+	} {
+		if got := tt.opts.Fingerprint(); got != tt.fingerprint {
+			t.Errorf("%+v: invalid fingerprint, want %q (got %q)", tt.opts, tt.fingerprint, got)
+		}
+	}
+}
+
+func TestOptionsFingerprintTables(t *testing.T) {
+	for _, tt := range []struct {
+		opts   Options
+		change func() (undo func())
+	}{
+		{Options{Orthography: true}, func() func() {
+			orthographyWords["tecto"] = "tecto"
+			return func() { orthographyWords["tecto"] = "teto" }
+		}},
+		{Options{DetachClitics: true}, func() func() {
+			delete(cliticIrregulars, "fi")
+			return func() { cliticIrregulars["fi"] = "fiz" }
+		}},
+		{Options{ExpandContractions: true}, func() func() {
+			contractions["ao"] = []string{"a", "ao"}
+			return func() { contractions["ao"] = []string{"a", "o"} }
+		}},
+	} {
+		before := tt.opts.Fingerprint()
+		undo := tt.change()
+		after := tt.opts.Fingerprint()
+		undo()
+
+		if before == after {
+			t.Errorf("%+v: the fingerprint doesn't depend on the tables", tt.opts)
+		}
+		if tt.opts.Fingerprint() != before {
+			t.Fatalf("%+v: the tables were not restored", tt.opts)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package rslp
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteRules writes the rule set as a rule file that LoadRules can read,
+// with the flow between the steps declared before them, a "# semantics:
+// lucene" line for rule sets with the semantics of Lucene's RSLPStemmerBase
+// and a "# fold: name" line for rule sets not removing all the diacritics.
+func (rs *RuleSet) WriteRules(w io.Writer) error {
+	if err := checkWritable(rs); err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Rule set %q, in the RSLP format read by rslp.LoadRules.\n", rs.name)
+	if rs.version != "" {
+		fmt.Fprintf(&b, "# version: %s\n", rs.version)
+	}
+	if rs.lucene {
+		b.WriteString("# semantics: lucene\n")
+	}
+	if rs.fold != "normalize" {
+		fmt.Fprintf(&b, "# fold: %s\n", rs.fold)
+	}
+	b.WriteString("\n")
+
+	for i, name := range rs.order {
+		s := rs.steps[name]
+		end := ","
+		if i == len(rs.order)-1 {
+			end = ";"
+		}
+		fmt.Fprintf(&b, "{ %s, %s, %s }%s\n", quote(name), quote(s.stepPass), quote(s.stepFail), end)
+	}
+
+	for _, name := range rs.order {
+		s := rs.steps[name]
+		entireWord := 0
+		if s.entireWord {
+			entireWord = 1
+		}
+		fmt.Fprintf(&b, "\n{ %s, %d, %d, {%s},\n", quote(name), s.minLength, entireWord, quoteList(s.endWords))
+		for i, r := range s.rules {
+			end := ","
+			if i == len(s.rules)-1 {
+				end = "};"
+			}
+			if len(r.exceptions) == 0 {
+				fmt.Fprintf(&b, "{%s,%d,%s}%s\n", quote(r.suffix), r.minLength, quote(r.replacement), end)
+			} else {
+				fmt.Fprintf(&b, "{%s,%d,%s,{%s}}%s\n", quote(r.suffix), r.minLength, quote(r.replacement), quoteList(r.exceptions), end)
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// checkWritable returns an error if a step has no rules or a string of the
+// rule set can't be written in a rule file, which has no escape sequences.
+func checkWritable(rs *RuleSet) error {
+	check := func(s string) error {
+		if strings.ContainsAny(s, "\",\n\r") {
+			return fmt.Errorf("rslp: %q can't be written in a rule file", s)
+		}
+		return nil
+	}
+
+	for _, name := range rs.order {
+		s := rs.steps[name]
+		if len(s.rules) == 0 {
+			return fmt.Errorf("rslp: step %q has no rules", name)
+		}
+		strs := append([]string{name, s.stepPass, s.stepFail}, s.endWords...)
+		for _, r := range s.rules {
+			strs = append(strs, r.suffix, r.replacement)
+			strs = append(strs, r.exceptions...)
+		}
+		for _, str := range strs {
+			if err := check(str); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func quote(s string) string {
+	return `"` + s + `"`
+}
+
+func quoteList(list []string) string {
+	quoted := make([]string, len(list))
+	for i, s := range list {
+		quoted[i] = quote(s)
+	}
+	return strings.Join(quoted, ",")
+}
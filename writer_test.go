@@ -0,0 +1,49 @@
+package rslp
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteRules(t *testing.T) {
+	for _, rs := range []*RuleSet{Portuguese, PortugueseExtended, LucenePortuguese, Galician} {
+		var buf bytes.Buffer
+		if err := rs.WriteRules(&buf); err != nil {
+			t.Fatal(err)
+		}
+		loaded, err := LoadRules(rs.Name(), &buf)
+		if err != nil {
+			t.Fatalf("%s: %v", rs.Name(), err)
+		}
+		if loaded.lucene != rs.lucene {
+			t.Fatalf("%s: invalid semantics, want lucene %v (got %v)", rs.Name(), rs.lucene, loaded.lucene)
+		}
+		if loaded.fold != rs.fold {
+			t.Fatalf("%s: invalid fold, want %q (got %q)", rs.Name(), rs.fold, loaded.fold)
+		}
+		if loaded.Fingerprint() != rs.Fingerprint() {
+			t.Fatalf("%s: the reloaded rule set has another fingerprint", rs.Name())
+		}
+		for _, tt := range stemTests {
+			if got, want := (Options{Rules: loaded}).Stem(tt.input), (Options{Rules: rs}).Stem(tt.input); got != want {
+				t.Fatalf("%s: invalid stem output, %q -> %q (got %q)", rs.Name(), tt.input, want, got)
+			}
+		}
+	}
+}
+
+func TestWriteRulesErrors(t *testing.T) {
+	for _, exception := range []string{`a"b`, "a,b", "a\nb"} {
+		rs := Portuguese.clone()
+		rs.steps["Plural"].rules[0].exceptions = []string{exception}
+		if err := rs.WriteRules(&bytes.Buffer{}); err == nil {
+			t.Fatalf("expected an error for %q", exception)
+		}
+	}
+
+	rs := Portuguese.clone()
+	rs.steps["Plural"].rules = nil
+	if err := rs.WriteRules(&bytes.Buffer{}); err == nil {
+		t.Fatal("expected an error for a step without rules")
+	}
+}